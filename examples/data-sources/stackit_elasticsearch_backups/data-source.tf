@@ -0,0 +1,10 @@
+resource "stackit_elasticsearch_instance" "example" {
+  name            = "example"
+  project_id      = var.project_id
+  backup_schedule = "0 2 * * *"
+}
+
+data "stackit_elasticsearch_backups" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_elasticsearch_instance.example.id
+}
@@ -0,0 +1,7 @@
+data "stackit_object_storage_object" "example" {
+  bucket_url        = stackit_object_storage_bucket.example.path_style_url
+  region            = stackit_object_storage_bucket.example.region
+  access_key        = stackit_object_storage_credential.example.access_key
+  secret_access_key = stackit_object_storage_credential.example.secret_access_key
+  key               = "bootstrap/config.json"
+}
@@ -0,0 +1,10 @@
+resource "stackit_opensearch_instance" "example" {
+  name            = "example"
+  project_id      = var.project_id
+  backup_schedule = "0 2 * * *"
+}
+
+data "stackit_opensearch_backups" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_opensearch_instance.example.id
+}
@@ -0,0 +1,14 @@
+data "stackit_postgres_offerings" "example" {
+  project_id = var.project_id
+}
+
+locals {
+  latest = one([for o in data.stackit_postgres_offerings.example.offerings : o if o.latest])
+}
+
+resource "stackit_postgres_instance" "example" {
+  name       = "example"
+  project_id = var.project_id
+  version    = local.latest.version
+  plan       = local.latest.plans[0].name
+}
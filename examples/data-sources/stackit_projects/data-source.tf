@@ -0,0 +1,10 @@
+data "stackit_projects" "example" {
+  parent_container_id = "container-id"
+  name_regex          = "^team-a-"
+  lifecycle_state     = "ACTIVE"
+  label_selectors     = ["billingReference=ABC-123", "env"]
+}
+
+output "project_ids" {
+  value = data.stackit_projects.example.projects[*].id
+}
@@ -0,0 +1,10 @@
+resource "stackit_rabbitmq_instance" "example" {
+  name            = "example"
+  project_id      = var.project_id
+  backup_schedule = "0 2 * * *"
+}
+
+data "stackit_rabbitmq_backups" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_rabbitmq_instance.example.id
+}
@@ -0,0 +1,9 @@
+data "stackit_resource_manager_container" "team" {
+  parent_container_id = var.organization_container_id
+  path                = "business-unit/team"
+}
+
+data "stackit_resource_manager_container" "business_unit" {
+  parent_container_id = var.organization_container_id
+  name                = "business-unit"
+}
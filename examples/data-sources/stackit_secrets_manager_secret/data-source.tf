@@ -0,0 +1,12 @@
+data "stackit_secrets_manager_secret" "example" {
+  instance_id = var.secrets_manager_instance_id
+  api_url     = var.secrets_manager_api_url
+  username    = var.secrets_manager_username
+  password    = var.secrets_manager_password
+  path        = "databases/example"
+}
+
+output "db_password" {
+  value     = data.stackit_secrets_manager_secret.example.data["password"]
+  sensitive = true
+}
@@ -17,3 +17,30 @@ provider "stackit" {
   service_account_key = var.service_account_key
   private_key         = var.private_key
 }
+
+# Credentials file profile
+provider "stackit" {
+  auth_method = "credentials_file"
+  profile     = "ci"
+}
+
+# Impersonation of a project's service account
+provider "stackit" {
+  alias       = "project"
+  auth_method = "key"
+
+  impersonate {
+    project_id            = var.project_id
+    service_account_email = var.project_service_account_email
+  }
+}
+
+# Second region with a private API gateway for Postgres Flex
+provider "stackit" {
+  alias  = "eu02"
+  region = "eu02"
+
+  endpoints {
+    postgres_flex = "https://postgres-flex.gateway.example.com"
+  }
+}
@@ -0,0 +1,7 @@
+resource "stackit_keypair" "example" {
+  name       = "example"
+  public_key = file("~/.ssh/id_ed25519.pub")
+  labels = {
+    "key" = "value"
+  }
+}
@@ -0,0 +1,13 @@
+resource "stackit_network" "example" {
+  project_id = "example"
+  name       = "example"
+
+  ipv4 = {
+    prefix      = "10.253.16.0/24"
+    nameservers = ["8.8.8.8", "8.8.4.4"]
+  }
+
+  ipv6 = {
+    prefix_length = 64
+  }
+}
@@ -0,0 +1,25 @@
+resource "stackit_network_area" "example" {
+  organization_id     = "example-organization-id"
+  name                = "example"
+  network_ranges      = ["10.0.0.0/16"]
+  transfer_network    = "192.168.0.0/24"
+  default_nameservers = ["8.8.8.8"]
+}
+
+// projects are added to the network area using `network_area_id`
+resource "stackit_project" "spoke" {
+  name                = "spoke"
+  parent_container_id = "parent-contaier-id"
+  billing_ref         = var.project_billing_ref
+  owner_email         = var.project_owner_email
+  network_area_id     = stackit_network_area.example.id
+}
+
+// the network prefix is allocated from the network ranges of the area
+resource "stackit_network" "spoke" {
+  project_id = stackit_project.spoke.id
+  name       = "spoke"
+  ipv4 = {
+    prefix_length = 24
+  }
+}
@@ -0,0 +1,6 @@
+resource "stackit_network_area_route" "example" {
+  organization_id = stackit_network_area.example.organization_id
+  network_area_id = stackit_network_area.example.id
+  prefix          = "172.16.0.0/24"
+  next_hop        = "10.0.0.5"
+}
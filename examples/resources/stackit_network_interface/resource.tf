@@ -0,0 +1,8 @@
+resource "stackit_network_interface" "example" {
+  project_id         = "example"
+  network_id         = stackit_network.example.id
+  name               = "example"
+  ipv4               = "10.0.0.10"
+  allowed_addresses  = ["10.0.0.100/32"]
+  security_group_ids = [stackit_security_group.example.id]
+}
@@ -2,3 +2,26 @@ resource "stackit_object_storage_bucket" "example" {
   project_id = stackit_object_storage_project.example.id
   name       = "example"
 }
+
+resource "stackit_object_storage_credential" "example" {
+  project_id = stackit_object_storage_project.example.id
+}
+
+resource "stackit_object_storage_bucket" "logs" {
+  project_id        = stackit_object_storage_project.example.id
+  name              = "logs"
+  access_key        = stackit_object_storage_credential.example.access_key
+  secret_access_key = stackit_object_storage_credential.example.secret_access_key
+
+  versioning = {
+    enabled = true
+  }
+
+  lifecycle_rule = [
+    {
+      prefix                             = "logs/"
+      expiration_days                    = 30
+      noncurrent_version_expiration_days = 7
+    }
+  ]
+}
@@ -0,0 +1,38 @@
+resource "stackit_object_storage_credential" "admin" {
+  project_id = stackit_object_storage_project.example.id
+}
+
+resource "stackit_object_storage_credentials_group" "backup" {
+  project_id = stackit_object_storage_project.example.id
+  name       = "backup"
+}
+
+resource "stackit_object_storage_credentials_group" "analytics" {
+  project_id = stackit_object_storage_project.example.id
+  name       = "analytics"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+  project_id = stackit_object_storage_project.example.id
+  name       = "backups"
+}
+
+resource "stackit_object_storage_bucket_access" "backup_writer" {
+  name                   = "backupwriter"
+  bucket_url             = stackit_object_storage_bucket.example.path_style_url
+  region                 = stackit_object_storage_bucket.example.region
+  access_key             = stackit_object_storage_credential.admin.access_key
+  secret_access_key      = stackit_object_storage_credential.admin.secret_access_key
+  permission             = "write"
+  credentials_group_urns = [stackit_object_storage_credentials_group.backup.urn]
+}
+
+resource "stackit_object_storage_bucket_access" "analytics_reader" {
+  name                   = "analyticsreader"
+  bucket_url             = stackit_object_storage_bucket.example.path_style_url
+  region                 = stackit_object_storage_bucket.example.region
+  access_key             = stackit_object_storage_credential.admin.access_key
+  secret_access_key      = stackit_object_storage_credential.admin.secret_access_key
+  permission             = "read"
+  credentials_group_urns = [stackit_object_storage_credentials_group.analytics.urn]
+}
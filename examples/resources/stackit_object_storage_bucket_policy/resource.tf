@@ -0,0 +1,27 @@
+resource "stackit_object_storage_credential" "example" {
+  project_id = stackit_object_storage_project.example.id
+}
+
+resource "stackit_object_storage_bucket" "example" {
+  project_id = stackit_object_storage_credential.example.project_id
+  name       = "example"
+}
+
+resource "stackit_object_storage_bucket_policy" "example" {
+  bucket_url        = stackit_object_storage_bucket.example.path_style_url
+  region            = stackit_object_storage_bucket.example.region
+  access_key        = stackit_object_storage_credential.example.access_key
+  secret_access_key = stackit_object_storage_credential.example.secret_access_key
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Sid       = "public-read"
+        Effect    = "Allow"
+        Principal = "*"
+        Action    = ["s3:GetObject"]
+        Resource  = ["arn:aws:s3:::example/*"]
+      }
+    ]
+  })
+}
@@ -7,3 +7,12 @@ resource "stackit_object_storage_credential" "example" {
   project_id           = stackit_object_storage_project.example.id
   credentials_group_id = stackit_object_storage_credentials_group.example.id
 }
+
+# keys expire after 30 days, a replacement is created 3 days before expiry
+# and the previous key stays valid for one more day
+resource "stackit_object_storage_credential" "rotating" {
+  project_id    = stackit_object_storage_project.example.id
+  rotation_days = 30
+  rotate_before = "72h"
+  overlap       = "24h"
+}
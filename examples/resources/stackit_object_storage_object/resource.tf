@@ -0,0 +1,32 @@
+resource "stackit_object_storage_credential" "example" {
+  project_id = stackit_object_storage_project.example.id
+}
+
+resource "stackit_object_storage_bucket" "example" {
+  project_id = stackit_object_storage_credential.example.project_id
+  name       = "example"
+}
+
+resource "stackit_object_storage_object" "index" {
+  bucket_url        = stackit_object_storage_bucket.example.path_style_url
+  region            = stackit_object_storage_bucket.example.region
+  access_key        = stackit_object_storage_credential.example.access_key
+  secret_access_key = stackit_object_storage_credential.example.secret_access_key
+  key               = "index.html"
+  source            = "${path.module}/site/index.html"
+  content_type      = "text/html"
+}
+
+resource "stackit_object_storage_object" "bootstrap" {
+  bucket_url        = stackit_object_storage_bucket.example.path_style_url
+  region            = stackit_object_storage_bucket.example.region
+  access_key        = stackit_object_storage_credential.example.access_key
+  secret_access_key = stackit_object_storage_credential.example.secret_access_key
+  key               = "bootstrap/config.json"
+  content           = jsonencode({ environment = "prod" })
+  content_type      = "application/json"
+
+  metadata = {
+    owner = "platform-team"
+  }
+}
@@ -1,4 +1,15 @@
+resource "stackit_argus_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+  plan       = "Monitoring-Medium-EU01"
+}
+
 resource "stackit_opensearch_instance" "example" {
   name       = "example"
   project_id = var.project_id
+  parameters = {
+    java_heapspace         = 2048
+    monitoring_instance_id = stackit_argus_instance.example.id
+    plugins                = ["repository-s3"]
+  }
 }
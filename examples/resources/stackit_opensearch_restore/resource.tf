@@ -0,0 +1,15 @@
+data "stackit_opensearch_backups" "example" {
+  project_id  = var.project_id
+  instance_id = var.instance_id
+}
+
+resource "stackit_opensearch_restore" "example" {
+  project_id  = var.project_id
+  instance_id = var.instance_id
+  backup_id   = data.stackit_opensearch_backups.example.latest_backup_id
+
+  # change the value to restore again
+  triggers = {
+    run = "1"
+  }
+}
@@ -0,0 +1,5 @@
+resource "stackit_project_member" "example" {
+  project_id = var.project_id
+  subject    = "developer@example.com"
+  role       = "project.member"
+}
@@ -0,0 +1,17 @@
+resource "stackit_project_members" "example" {
+  project_id = var.project_id
+  members = [
+    {
+      subject = "owner@example.com"
+      role    = "project.owner"
+    },
+    {
+      subject = "developer@example.com"
+      role    = "project.member"
+    },
+    {
+      subject = "auditor@example.com"
+      role    = "project.auditor"
+    },
+  ]
+}
@@ -0,0 +1,7 @@
+resource "stackit_public_ip" "example" {
+  project_id           = "example"
+  network_interface_id = stackit_network_interface.example.id
+  labels = {
+    "key" = "value"
+  }
+}
@@ -1,4 +1,7 @@
 resource "stackit_rabbitmq_instance" "example" {
   name       = "example"
   project_id = var.project_id
+  parameters = {
+    plugins = ["rabbitmq_consistent_hash_exchange", "rabbitmq_federation"]
+  }
 }
@@ -1,4 +1,8 @@
 resource "stackit_redis_instance" "example" {
   name       = "example"
   project_id = var.project_id
+  parameters = {
+    maxmemory_policy = "allkeys-lru"
+    tls_ciphers      = ["TLS_AES_256_GCM_SHA384"]
+  }
 }
@@ -0,0 +1,19 @@
+resource "stackit_resource_manager_folder" "business_unit" {
+  name                = "business-unit"
+  parent_container_id = var.organization_container_id
+}
+
+resource "stackit_resource_manager_folder" "team" {
+  name                = "team"
+  parent_container_id = stackit_resource_manager_folder.business_unit.container_id
+  labels = {
+    "owner" = "team"
+  }
+}
+
+resource "stackit_project" "example" {
+  name                = "example"
+  parent_container_id = stackit_resource_manager_folder.team.container_id
+  billing_ref         = var.project_billing_ref
+  owner_email         = var.project_owner_email
+}
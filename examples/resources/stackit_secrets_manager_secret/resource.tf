@@ -0,0 +1,40 @@
+resource "stackit_secrets_manager_instance" "example" {
+  project_id = var.project_id
+  name       = "example"
+}
+
+resource "stackit_secrets_manager_user" "example" {
+  project_id    = var.project_id
+  instance_id   = stackit_secrets_manager_instance.example.id
+  description   = "terraform"
+  write_enabled = true
+}
+
+resource "stackit_postgres_flex_instance" "example" {
+  name         = "example"
+  project_id   = var.project_id
+  machine_type = "2.4"
+  version      = "14"
+}
+
+resource "stackit_postgres_flex_user" "example" {
+  project_id  = var.project_id
+  instance_id = stackit_postgres_flex_instance.example.id
+}
+
+resource "stackit_secrets_manager_secret" "example" {
+  instance_id  = stackit_secrets_manager_instance.example.id
+  api_url      = stackit_secrets_manager_instance.example.api_url
+  username     = stackit_secrets_manager_user.example.username
+  password     = stackit_secrets_manager_user.example.password
+  path         = "databases/example"
+  max_versions = 5
+  data = {
+    username = stackit_postgres_flex_user.example.username
+    password = stackit_postgres_flex_user.example.password
+    uri      = stackit_postgres_flex_user.example.uri
+  }
+  custom_metadata = {
+    owner = "team"
+  }
+}
@@ -0,0 +1,8 @@
+resource "stackit_security_group" "example" {
+  project_id  = "example"
+  name        = "example"
+  description = "allow ssh from the internal network"
+  labels = {
+    "key" = "value"
+  }
+}
@@ -0,0 +1,17 @@
+resource "stackit_security_group_rule" "ssh" {
+  project_id        = "example"
+  security_group_id = stackit_security_group.example.id
+  description       = "ssh"
+  direction         = "ingress"
+  protocol          = "tcp"
+  port_range_min    = 22
+  port_range_max    = 22
+  remote_cidr       = "10.0.0.0/8"
+}
+
+resource "stackit_security_group_rule" "internal" {
+  project_id               = "example"
+  security_group_id        = stackit_security_group.example.id
+  direction                = "ingress"
+  remote_security_group_id = stackit_security_group.example.id
+}
@@ -0,0 +1,34 @@
+resource "stackit_network" "example" {
+  project_id = "example"
+  name       = "example"
+}
+
+resource "stackit_keypair" "example" {
+  name       = "example"
+  public_key = file("~/.ssh/id_ed25519.pub")
+}
+
+resource "stackit_server" "example" {
+  project_id        = "example"
+  name              = "example"
+  machine_type      = "c1.2"
+  availability_zone = "eu01-1"
+  network_id        = stackit_network.example.id
+  keypair_name      = stackit_keypair.example.name
+
+  boot_volume = {
+    source_type           = "image"
+    source_id             = "59838a89-51b1-4892-b57f-b3caf598ee2f"
+    size                  = 64
+    delete_on_termination = true
+  }
+
+  user_data = <<-EOT
+  #cloud-config
+  package_update: true
+  EOT
+
+  labels = {
+    "key" = "value"
+  }
+}
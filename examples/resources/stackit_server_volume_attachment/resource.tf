@@ -0,0 +1,5 @@
+resource "stackit_server_volume_attachment" "example" {
+  project_id = "example"
+  server_id  = stackit_server.example.id
+  volume_id  = stackit_volume.example.id
+}
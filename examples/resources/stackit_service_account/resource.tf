@@ -0,0 +1,4 @@
+resource "stackit_service_account" "example" {
+  project_id = "example"
+  name       = "ci"
+}
@@ -0,0 +1,15 @@
+resource "stackit_service_account" "example" {
+  project_id = "example"
+  name       = "ci"
+}
+
+resource "stackit_service_account_key" "example" {
+  project_id            = stackit_service_account.example.project_id
+  service_account_email = stackit_service_account.example.email
+  valid_until           = "2030-01-01T00:00:00Z"
+}
+
+output "service_account_key" {
+  value     = stackit_service_account_key.example.json
+  sensitive = true
+}
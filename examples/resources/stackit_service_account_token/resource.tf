@@ -0,0 +1,10 @@
+resource "stackit_service_account" "example" {
+  project_id = "example"
+  name       = "ci"
+}
+
+resource "stackit_service_account_token" "example" {
+  project_id            = stackit_service_account.example.project_id
+  service_account_email = stackit_service_account.example.email
+  ttl_days              = 30
+}
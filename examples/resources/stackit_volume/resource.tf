@@ -0,0 +1,9 @@
+resource "stackit_volume" "example" {
+  project_id        = "example"
+  name              = "example"
+  availability_zone = "eu01-1"
+  size              = 64
+  labels = {
+    "key" = "value"
+  }
+}
@@ -5,14 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	client "github.com/SchwarzIT/community-stackit-go-client"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// authentication methods
+const (
+	authKey             = "key"
+	authToken           = "token"
+	authCredentialsFile = "credentials_file"
+)
+
+var authMethods = []string{authKey, authToken, authCredentialsFile}
+
 func (p *StackitProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
 	var config providerSchema
@@ -44,27 +56,108 @@ func (p *StackitProvider) Configure(ctx context.Context, req provider.ConfigureR
 		config.PrivateKeyPath = types.StringValue(os.Getenv(PrivateKeyPath))
 	}
 
+	// Credentials file
+	if config.AuthMethod.IsUnknown() || config.AuthMethod.IsNull() {
+		config.AuthMethod = types.StringValue(os.Getenv(AuthMethod))
+	}
+	if config.CredentialsFile.IsUnknown() || config.CredentialsFile.IsNull() {
+		config.CredentialsFile = types.StringValue(os.Getenv(CredentialsFile))
+	}
+	if config.Profile.IsUnknown() || config.Profile.IsNull() {
+		config.Profile = types.StringValue(os.Getenv(Profile))
+	}
+
+	// Region
+	if config.Region.IsUnknown() || config.Region.IsNull() {
+		config.Region = types.StringValue(os.Getenv(Region))
+	}
+
 	if os.Getenv("TF_ACC") == "1" {
 		config.EnableTraceContext = types.BoolValue(true)
 	}
 
-	var err error
+	c, summary, err := authenticate(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(summary, err.Error())
+		return
+	}
+	p.setClient(ctx, resp, c, config)
+}
+
+// authenticate initializes the client with the configured authentication method
+// if no method is configured, the key flow is tried before the token flow
+// on failure, the returned summary states which flow was attempted
+func authenticate(ctx context.Context, config providerSchema) (*services.Services, string, error) {
+	method := config.AuthMethod.ValueString()
+	switch method {
+	case authKey:
+		c, err := keyFlow(ctx, config)
+		return c, "couldn't authenticate with the key flow (auth_method = \"key\")", err
+	case authToken:
+		c, err := tokenFlow(ctx, config)
+		return c, "couldn't authenticate with the token flow (auth_method = \"token\")", err
+	case authCredentialsFile:
+		c, err := credentialsFileFlow(ctx, config)
+		return c, fmt.Sprintf("couldn't authenticate with profile %q of the credentials file (auth_method = \"credentials_file\")", profileName(config)), err
+	case "":
+	default:
+		return nil, "invalid authentication method", fmt.Errorf("auth_method %q isn't supported, use one of: %s", method, strings.Join(authMethods, ", "))
+	}
 
 	kfcl, err := keyFlow(ctx, config)
 	if err == nil {
-		resp.DataSourceData = kfcl
-		resp.ResourceData = kfcl
-		return
+		return kfcl, "", nil
 	}
 
 	tfcl, err2 := tokenFlow(ctx, config)
 	if err2 == nil {
-		resp.DataSourceData = tfcl
-		resp.ResourceData = tfcl
+		return tfcl, "", nil
+	}
+
+	return nil, "couldn't initialize client with an authentication flow", fmt.Errorf("no auth_method is configured, the key flow and the token flow were attempted\n\nkey flow client auth:\n%s\n\ntoken flow client auth:\n%s", err.Error(), err2.Error())
+}
+
+// setClient configures the endpoints of the client and passes it to resources and data sources
+// if impersonation is configured, the client acts as the impersonated service account
+func (p *StackitProvider) setClient(ctx context.Context, resp *provider.ConfigureResponse, c *services.Services, config providerSchema) {
+	if err := configureEndpoints(c, config.Region.ValueString(), config.Endpoints); err != nil {
+		resp.Diagnostics.AddError("couldn't configure API endpoints", err.Error())
 		return
 	}
 
-	resp.Diagnostics.AddError("couldn't initialize client with an authentication flow", fmt.Sprintf("key flow client auth:\n%s\n\ntoken flow client auth:\n%s", err.Error(), err2.Error()))
+	if !config.Impersonate.IsNull() && !config.Impersonate.IsUnknown() {
+		var imp impersonation
+		resp.Diagnostics.Append(config.Impersonate.As(ctx, &imp, objectAsOptions)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// an empty block doesn't impersonate
+		if imp.ServiceAccountEmail.ValueString() != "" {
+			if imp.TTLDays.ValueInt64() > DefaultImpersonationTTLDays {
+				resp.Diagnostics.AddAttributeWarning(path.Root("impersonate").AtName("ttl_days"), "long-lived impersonation access token",
+					fmt.Sprintf("access tokens for impersonation aren't revoked and stay valid for %d days, consider the default of %d day", imp.TTLDays.ValueInt64(), DefaultImpersonationTTLDays))
+			}
+			ic, err := impersonate(ctx, &resp.Diagnostics, c, imp, impersonationTokensFile(config), config.EnableTraceContext.ValueBool())
+			if err != nil {
+				resp.Diagnostics.AddError(fmt.Sprintf("couldn't impersonate service account %s", imp.ServiceAccountEmail.ValueString()), err.Error())
+				return
+			}
+			if err := configureEndpoints(ic, config.Region.ValueString(), config.Endpoints); err != nil {
+				resp.Diagnostics.AddError("couldn't configure API endpoints", err.Error())
+				return
+			}
+			c = ic
+		}
+	}
+
+	// only the client passed to resources and data sources has a response cache
+	if p.client != nil && p.client != c {
+		cache.Release(p.client)
+	}
+	p.client = c
+
+	resp.DataSourceData = c
+	resp.ResourceData = c
 }
 
 func keyFlow(ctx context.Context, config providerSchema) (*services.Services, error) {
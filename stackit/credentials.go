@@ -0,0 +1,269 @@
+package stackit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	client "github.com/SchwarzIT/community-stackit-go-client"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	// DefaultCredentialsFile is used if no credentials file is configured
+	DefaultCredentialsFile = "~/.stackit/credentials.json"
+
+	// DefaultProfile is used if no profile is configured
+	DefaultProfile = "default"
+
+	// DefaultImpersonationTTLDays is the lifetime of access tokens created for impersonation
+	// it's the shortest lifetime the API allows
+	DefaultImpersonationTTLDays = 1
+
+	// ImpersonationTokensFile caches the access tokens created for impersonation,
+	// it's stored in the directory of the credentials file
+	ImpersonationTokensFile = "impersonation_tokens.json"
+
+	// minTokenLifetime is the remaining lifetime a cached access token needs to be reused
+	minTokenLifetime = time.Hour
+)
+
+var objectAsOptions = basetypes.ObjectAsOptions{}
+
+// credentialsProfile is a named profile of the credentials file
+// the service account key and private key can either be set directly or as file paths
+type credentialsProfile struct {
+	// Key Flow
+	ServiceAccountKey     json.RawMessage `json:"service_account_key,omitempty"`
+	PrivateKey            string          `json:"private_key,omitempty"`
+	ServiceAccountKeyPath string          `json:"service_account_key_path,omitempty"`
+	PrivateKeyPath        string          `json:"private_key_path,omitempty"`
+
+	// Token Flow
+	ServiceAccountEmail string `json:"service_account_email,omitempty"`
+	ServiceAccountToken string `json:"service_account_token,omitempty"`
+}
+
+// impersonation is the `impersonate` block of the provider
+type impersonation struct {
+	ProjectID           types.String `tfsdk:"project_id"`
+	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
+	TTLDays             types.Int64  `tfsdk:"ttl_days"`
+}
+
+func profileName(config providerSchema) string {
+	if p := config.Profile.ValueString(); p != "" {
+		return p
+	}
+	return DefaultProfile
+}
+
+// credentialsFileFlow authenticates with the key flow or token flow, depending on the credentials in the profile
+func credentialsFileFlow(ctx context.Context, config providerSchema) (*services.Services, error) {
+	path := config.CredentialsFile.ValueString()
+	if path == "" {
+		path = DefaultCredentialsFile
+	}
+	p, err := loadProfile(path, profileName(config))
+	if err != nil {
+		return nil, err
+	}
+
+	// relative paths in the profile are relative to the credentials file
+	dir := filepath.Dir(expandHome(path))
+	pc := providerSchema{
+		ServiceAccountKey:     types.StringValue(serviceAccountKey(p.ServiceAccountKey)),
+		PrivateKey:            types.StringValue(p.PrivateKey),
+		ServiceAccountKeyPath: types.StringValue(relativeTo(dir, p.ServiceAccountKeyPath)),
+		PrivateKeyPath:        types.StringValue(relativeTo(dir, p.PrivateKeyPath)),
+		ServiceAccountEmail:   types.StringValue(p.ServiceAccountEmail),
+		ServiceAccountToken:   types.StringValue(p.ServiceAccountToken),
+		EnableTraceContext:    config.EnableTraceContext,
+	}
+
+	switch {
+	case len(p.ServiceAccountKey) > 0 || p.ServiceAccountKeyPath != "":
+		c, err := keyFlow(ctx, pc)
+		if err != nil {
+			return nil, fmt.Errorf("key flow client auth:\n%w", err)
+		}
+		return c, nil
+	case p.ServiceAccountToken != "":
+		c, err := tokenFlow(ctx, pc)
+		if err != nil {
+			return nil, fmt.Errorf("token flow client auth:\n%w", err)
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("profile %q in %s has neither a service account key nor a service account token", profileName(config), path)
+}
+
+// loadProfile reads a named profile from a credentials file
+// the file is a JSON object with profile names as keys
+func loadProfile(path, name string) (*credentialsProfile, error) {
+	b, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read credentials file: %w", err)
+	}
+
+	profiles := map[string]credentialsProfile{}
+	if err := json.Unmarshal(b, &profiles); err != nil {
+		return nil, fmt.Errorf("couldn't parse credentials file %s: %w", path, err)
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		names := []string{}
+		for n := range profiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %q not found in %s, available profiles are: %s", name, path, strings.Join(names, ", "))
+	}
+	return &p, nil
+}
+
+// serviceAccountKey returns the key as string, which can be set as JSON object or as string in the profile
+func serviceAccountKey(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
+
+func relativeTo(dir, path string) string {
+	path = expandHome(path)
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// impersonate returns a client which authenticates with an access token of the impersonated service account
+// a token cached in tokensFile is reused while it's valid for at least minTokenLifetime,
+// otherwise a new token is created and cached, as tokens can't be listed or recovered later
+// the authenticated identity needs permission to create access tokens for the service account
+func impersonate(ctx context.Context, diags *diag.Diagnostics, c *services.Services, imp impersonation, tokensFile string, enableTraceContext bool) (*services.Services, error) {
+	ttl := int(imp.TTLDays.ValueInt64())
+	if imp.TTLDays.IsNull() || imp.TTLDays.IsUnknown() {
+		ttl = DefaultImpersonationTTLDays
+	}
+
+	email := imp.ServiceAccountEmail.ValueString()
+	// tokens are only reused by the identity which created them
+	key := c.Client.GetServiceAccountEmail() + "/" + email
+	token, ok := loadImpersonationToken(tokensFile, key)
+	if !ok {
+		res, err := c.ServiceAccounts.CreateAccessTokens(ctx, imp.ProjectID.ValueString(), openapiTypes.Email(email), serviceaccounts.CreateAccessTokensJSONRequestBody{
+			TtlDays: ttl,
+		})
+		if agg := validate.Response(res, err, "JSON201"); agg != nil {
+			return nil, fmt.Errorf("%s couldn't create an access token in project %s: %w", c.Client.GetServiceAccountEmail(), imp.ProjectID.ValueString(), agg)
+		}
+		token = res.JSON201.Token
+		if err := storeImpersonationToken(tokensFile, key, impersonationToken{Token: token, ValidUntil: res.JSON201.ValidUntil}); err != nil {
+			diags.AddWarning("couldn't cache the impersonation access token", fmt.Sprintf("a new access token is created the next time the provider is configured: %s", err))
+		}
+	}
+
+	return client.NewClientWithTokenAuth(ctx, clients.TokenFlowConfig{
+		ServiceAccountEmail: email,
+		ServiceAccountToken: token,
+		EnableTraceparent:   enableTraceContext,
+	})
+}
+
+// impersonationToken is an access token cached in the impersonation tokens file
+type impersonationToken struct {
+	Token      string    `json:"token"`
+	ValidUntil time.Time `json:"valid_until"`
+}
+
+// impersonationTokensFile returns the path of the file which caches impersonation tokens,
+// it's next to the credentials file as it's just as sensitive
+func impersonationTokensFile(config providerSchema) string {
+	path := config.CredentialsFile.ValueString()
+	if path == "" {
+		path = DefaultCredentialsFile
+	}
+	return filepath.Join(filepath.Dir(expandHome(path)), ImpersonationTokensFile)
+}
+
+// loadImpersonationTokens reads the cached tokens, a missing or invalid file has no tokens
+func loadImpersonationTokens(path string) map[string]impersonationToken {
+	tokens := map[string]impersonationToken{}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return tokens
+	}
+	if err := json.Unmarshal(b, &tokens); err != nil {
+		return map[string]impersonationToken{}
+	}
+	return tokens
+}
+
+// loadImpersonationToken returns the cached token if it's valid for at least minTokenLifetime
+func loadImpersonationToken(path, key string) (string, bool) {
+	t, ok := loadImpersonationTokens(path)[key]
+	if !ok || t.Token == "" || time.Until(t.ValidUntil) < minTokenLifetime {
+		return "", false
+	}
+	return t.Token, true
+}
+
+// storeImpersonationToken caches the token and drops expired tokens
+func storeImpersonationToken(path, key string, token impersonationToken) error {
+	tokens := loadImpersonationTokens(path)
+	for k, t := range tokens {
+		if time.Now().After(t.ValidUntil) {
+			delete(tokens, k)
+		}
+	}
+	tokens[key] = token
+
+	b, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// write to a temporary file first, as other provider instances may read the file concurrently
+	tmp, err := os.CreateTemp(filepath.Dir(path), ImpersonationTokensFile+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
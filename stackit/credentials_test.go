@@ -0,0 +1,207 @@
+package stackit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCredentials = `{
+	"default": {
+		"service_account_email": "default@example.com",
+		"service_account_token": "token"
+	},
+	"ci": {
+		"service_account_key_path": "sa_key.json",
+		"private_key_path": "/keys/private_key.pem"
+	},
+	"empty": {}
+}`
+
+func TestLoadProfile(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "credentials.json")
+	if err := os.WriteFile(path, []byte(testCredentials), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		profile string
+		want    string
+		wantErr string
+	}{
+		{"token profile", path, "default", "default@example.com", ""},
+		{"key profile", path, "ci", "", ""},
+		{"unknown profile", path, "prod", "", `profile "prod" not found in ` + path + `, available profiles are: ci, default, empty`},
+		{"missing file", filepath.Join(dir, "missing.json"), "default", "", "couldn't read credentials file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadProfile(tt.path, tt.profile)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("loadProfile() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.ServiceAccountEmail != tt.want {
+				t.Errorf("loadProfile() email = %v, want %v", got.ServiceAccountEmail, tt.want)
+			}
+		})
+	}
+
+	if got := relativeTo(dir, "sa_key.json"); got != filepath.Join(dir, "sa_key.json") {
+		t.Errorf("relativeTo() = %v", got)
+	}
+	if got := relativeTo(dir, "/keys/private_key.pem"); got != "/keys/private_key.pem" {
+		t.Errorf("relativeTo() = %v", got)
+	}
+	if got := serviceAccountKey([]byte(`{"id":"1"}`)); got != `{"id":"1"}` {
+		t.Errorf("serviceAccountKey() = %v", got)
+	}
+	if got := serviceAccountKey([]byte(`"{\"id\":\"1\"}"`)); got != `{"id":"1"}` {
+		t.Errorf("serviceAccountKey() = %v", got)
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	if err := os.WriteFile(path, []byte(testCredentials), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := func(method, profile string) providerSchema {
+		return providerSchema{
+			AuthMethod:            types.StringValue(method),
+			CredentialsFile:       types.StringValue(path),
+			Profile:               types.StringValue(profile),
+			ServiceAccountEmail:   types.StringValue(""),
+			ServiceAccountToken:   types.StringValue(""),
+			ServiceAccountKey:     types.StringValue(""),
+			ServiceAccountKeyPath: types.StringValue(""),
+			PrivateKey:            types.StringValue(""),
+			PrivateKeyPath:        types.StringValue(""),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		config      providerSchema
+		wantEmail   string
+		wantSummary string
+		wantErr     string
+	}{
+		{"credentials file default profile", config("credentials_file", ""), "default@example.com", "", ""},
+		{"credentials file empty profile", config("credentials_file", "empty"), "", `couldn't authenticate with profile "empty" of the credentials file`, "has neither a service account key nor a service account token"},
+		{"credentials file key profile", config("credentials_file", "ci"), "", `couldn't authenticate with profile "ci" of the credentials file`, "key flow client auth"},
+		{"token flow", config("token", ""), "", "couldn't authenticate with the token flow", "no proper settings found for token flow"},
+		{"key flow", config("key", ""), "", "couldn't authenticate with the key flow", ""},
+		{"no method", config("", ""), "", "couldn't initialize client with an authentication flow", "the key flow and the token flow were attempted"},
+		{"invalid method", config("password", ""), "", "invalid authentication method", `auth_method "password" isn't supported`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, summary, err := authenticate(context.Background(), tt.config)
+			if tt.wantSummary != "" {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if !strings.HasPrefix(summary, tt.wantSummary) {
+					t.Errorf("authenticate() summary = %v, want %v", summary, tt.wantSummary)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("authenticate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := c.Client.GetServiceAccountEmail(); got != tt.wantEmail {
+				t.Errorf("authenticate() email = %v, want %v", got, tt.wantEmail)
+			}
+		})
+	}
+}
+
+func TestImpersonationTokens(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	path := filepath.Join(t.TempDir(), "stackit", ImpersonationTokensFile)
+	if _, ok := loadImpersonationToken(path, "caller/sa"); ok {
+		t.Fatal("expected no token without tokens file")
+	}
+
+	tokens := map[string]impersonationToken{
+		"caller/valid":    {Token: "valid", ValidUntil: time.Now().Add(24 * time.Hour)},
+		"caller/expiring": {Token: "expiring", ValidUntil: time.Now().Add(time.Minute)},
+		"caller/expired":  {Token: "expired", ValidUntil: time.Now().Add(-time.Minute)},
+	}
+	for k, v := range tokens {
+		if err := storeImpersonationToken(path, k, v); err != nil {
+			t.Fatalf("storeImpersonationToken() error = %v", err)
+		}
+	}
+
+	tests := []struct {
+		key    string
+		want   string
+		wantOK bool
+	}{
+		{"caller/valid", "valid", true},
+		{"caller/expiring", "", false},
+		{"caller/expired", "", false},
+		{"other/valid", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok := loadImpersonationToken(path, tt.key)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("loadImpersonationToken() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	// expired tokens are dropped when a token is stored
+	if err := storeImpersonationToken(path, "caller/other", tokens["caller/valid"]); err != nil {
+		t.Fatalf("storeImpersonationToken() error = %v", err)
+	}
+	if _, ok := loadImpersonationTokens(path)["caller/expired"]; ok {
+		t.Error("expected expired token to be dropped")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("tokens file mode = %v, want 0600", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,190 @@
+package stackit
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	argus "github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0"
+	costs "github.com/SchwarzIT/community-stackit-go-client/pkg/services/costs/v2.0"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	iaas "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1"
+	kubernetes "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1"
+	loadbalancer "github.com/SchwarzIT/community-stackit-go-client/pkg/services/load-balancer/1.3.0"
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	mongodbflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0"
+	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+	postgresflex "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0"
+	resourcemanagement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	secretsmanager "github.com/SchwarzIT/community-stackit-go-client/pkg/services/secrets-manager/v1.1.0"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultRegion is the region of the default API base URLs
+const DefaultRegion = "eu01"
+
+// endpoint is a service API whose base URL can be configured in the `endpoints` block
+type endpoint struct {
+	urls baseurl.BaseURL
+	set  func(s *services.Services, c contracts.BaseClientInterface, u string) error
+}
+
+// endpoints maps the attributes of the `endpoints` block to the service clients
+var endpoints = map[string]endpoint{
+	"argus": {argus.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.Argus, err = argus.NewClient(u, argus.WithHTTPClient(c))
+		return
+	}},
+	"costs": {costs.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.Costs, err = costs.NewClient(u, costs.WithHTTPClient(c))
+		return
+	}},
+	"iaas": {iaas.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.IAAS, err = iaas.NewClient(u, iaas.WithHTTPClient(c))
+		return
+	}},
+	"kubernetes": {kubernetes.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.Kubernetes, err = kubernetes.NewClient(u, kubernetes.WithHTTPClient(c))
+		return
+	}},
+	"load_balancer": {loadbalancer.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.LoadBalancer, err = loadbalancer.NewClient(u, loadbalancer.WithHTTPClient(c))
+		return
+	}},
+	"membership": {membership.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) error {
+		s.Membership = membership.NewClient(u, c)
+		return nil
+	}},
+	"mongodb_flex": {mongodbflex.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.MongoDBFlex, err = mongodbflex.NewClient(u, mongodbflex.WithHTTPClient(c))
+		return
+	}},
+	"object_storage": {objectstorage.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.ObjectStorage, err = objectstorage.NewClient(u, objectstorage.WithHTTPClient(c))
+		return
+	}},
+	"postgres_flex": {postgresflex.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.PostgresFlex, err = postgresflex.NewClient(u, postgresflex.WithHTTPClient(c))
+		return
+	}},
+	"resource_management": {resourcemanagement.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.ResourceManagement, err = resourcemanagement.NewClient(u, resourcemanagement.WithHTTPClient(c))
+		return
+	}},
+	"secrets_manager": {secretsmanager.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.SecretsManager, err = secretsmanager.NewClient(u, secretsmanager.WithHTTPClient(c))
+		return
+	}},
+	"service_accounts": {serviceaccounts.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) error {
+		s.ServiceAccounts = serviceaccounts.NewClient(u, c)
+		return nil
+	}},
+	"service_enablement": {serviceenablement.BaseURLs, func(s *services.Services, c contracts.BaseClientInterface, u string) error {
+		s.ServiceEnablement = serviceenablement.NewClient(u, c)
+		return nil
+	}},
+
+	// DSA
+	"elasticsearch": {dataservices.GetBaseURLs(dataservices.ElasticSearch), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.ElasticSearch, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"logme": {dataservices.GetBaseURLs(dataservices.LogMe), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.LogMe, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"mariadb": {dataservices.GetBaseURLs(dataservices.MariaDB), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.MariaDB, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"opensearch": {dataservices.GetBaseURLs(dataservices.Opensearch), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.Opensearch, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"postgres": {dataservices.GetBaseURLs(dataservices.PostgresDB), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.PostgresDB, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"rabbitmq": {dataservices.GetBaseURLs(dataservices.RabbitMQ), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.RabbitMQ, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+	"redis": {dataservices.GetBaseURLs(dataservices.Redis), func(s *services.Services, c contracts.BaseClientInterface, u string) (err error) {
+		s.Redis, err = dataservices.NewClient(u, dataservices.WithHTTPClient(c))
+		return
+	}},
+}
+
+// endpointsBlock returns the schema of the `endpoints` block
+func endpointsBlock() schema.SingleNestedBlock {
+	attrs := map[string]schema.Attribute{}
+	for name, e := range endpoints {
+		attrs[name] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: fmt.Sprintf("Base URL of the API, replacing `%s`.<br />This attribute can also be loaded from `%s` environment variable instead.", e.urls.BaseURL, e.urls.OverrideWith),
+		}
+	}
+	return schema.SingleNestedBlock{
+		MarkdownDescription: "Overrides the base URL of service APIs, for example to use a private API gateway. Configured URLs take precedence over `region`.",
+		Attributes:          attrs,
+	}
+}
+
+// configureEndpoints points the service clients to the configured region and endpoints
+// base URLs are resolved in the following order:
+// 1. the `endpoints` block
+// 2. the service's environment variable, e.g. `STACKIT_KUBERNETES_BASEURL`
+// 3. the default base URL in the configured region
+func configureEndpoints(s *services.Services, region string, overrides types.Object) error {
+	configured := map[string]string{}
+	if !overrides.IsNull() && !overrides.IsUnknown() {
+		for name, v := range overrides.Attributes() {
+			if sv, ok := v.(types.String); ok && sv.ValueString() != "" {
+				configured[name] = sv.ValueString()
+			}
+		}
+	}
+	if len(configured) == 0 && (region == "" || region == DefaultRegion) {
+		return nil
+	}
+
+	for name, e := range endpoints {
+		u := resolveURL(e.urls, region, configured[name])
+		if u == e.urls.Get() {
+			continue
+		}
+		if _, err := url.ParseRequestURI(u); err != nil {
+			return fmt.Errorf("invalid URL for endpoint %s: %w", name, err)
+		}
+		c, ok := s.Client.Clone().(contracts.BaseClientInterface)
+		if !ok {
+			return fmt.Errorf("couldn't clone client for endpoint %s", name)
+		}
+		if err := e.set(s, c, u); err != nil {
+			return fmt.Errorf("couldn't configure endpoint %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// resolveURL returns the base URL of a service for the given region and configured override
+func resolveURL(u baseurl.BaseURL, region, configured string) string {
+	if configured != "" {
+		return configured
+	}
+	if v := os.Getenv(u.OverrideWith); v != "" {
+		return v
+	}
+	if region == "" {
+		return u.BaseURL
+	}
+	// global APIs don't contain a region and are kept as they are
+	return strings.Replace(u.BaseURL, "."+DefaultRegion+".", "."+region+".", 1)
+}
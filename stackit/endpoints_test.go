@@ -0,0 +1,144 @@
+package stackit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	client "github.com/SchwarzIT/community-stackit-go-client"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/clients"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/dsa"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestResolveURL(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	regional := baseurl.New("test_regional", "https://ske.api.eu01.stackit.cloud/")
+	global := baseurl.New("test_global", "https://resource-manager.api.stackit.cloud/v2/")
+
+	tests := []struct {
+		name       string
+		url        baseurl.BaseURL
+		env        string
+		region     string
+		configured string
+		want       string
+	}{
+		{"default", regional, "", "", "", "https://ske.api.eu01.stackit.cloud/"},
+		{"region", regional, "", "eu02", "", "https://ske.api.eu02.stackit.cloud/"},
+		{"global API", global, "", "eu02", "", "https://resource-manager.api.stackit.cloud/v2/"},
+		{"environment", regional, "https://env.example.com", "eu02", "", "https://env.example.com"},
+		{"configured", regional, "https://env.example.com", "eu02", "https://gateway.example.com", "https://gateway.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(tt.url.OverrideWith, tt.env)
+			if got := resolveURL(tt.url, tt.region, tt.configured); got != tt.want {
+				t.Errorf("resolveURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigureEndpoints(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	c, err := client.NewClientWithTokenAuth(context.Background(), clients.TokenFlowConfig{
+		ServiceAccountEmail: "test@example.com",
+		ServiceAccountToken: "token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for name := range endpoints {
+		attrTypes[name] = types.StringType
+		attrs[name] = types.StringNull()
+	}
+	attrs["postgres_flex"] = types.StringValue("https://gateway.example.com/postgres-flex")
+	overrides := types.ObjectValueMust(attrTypes, attrs)
+
+	if err := configureEndpoints(c, "eu02", overrides); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.PostgresFlex.Client.Server; got != "https://gateway.example.com/postgres-flex/" {
+		t.Errorf("postgres flex server = %v", got)
+	}
+	if got := c.Kubernetes.Client.Server; got != "https://ske.api.eu02.stackit.cloud/" {
+		t.Errorf("kubernetes server = %v", got)
+	}
+
+	attrs["kubernetes"] = types.StringValue("not a url")
+	if err := configureEndpoints(c, "", types.ObjectValueMust(attrTypes, attrs)); err == nil {
+		t.Error("expected error for invalid URL")
+	}
+}
+
+func TestConfigureEndpointsInternalClients(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	c, err := client.NewClientWithTokenAuth(context.Background(), clients.TokenFlowConfig{
+		ServiceAccountEmail: "test@example.com",
+		ServiceAccountToken: "token",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attrTypes := map[string]attr.Type{}
+	attrs := map[string]attr.Value{}
+	for name := range endpoints {
+		attrTypes[name] = types.StringType
+		attrs[name] = types.StringNull()
+	}
+	attrs["iaas"] = types.StringValue(srv.URL + "/iaas")
+	attrs["redis"] = types.StringValue(srv.URL + "/redis")
+
+	if err := configureEndpoints(c, "eu02", types.ObjectValueMust(attrTypes, attrs)); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := iaas.New(c.IAAS).GetServer(ctx, "project", "server"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dsa.New(c.Redis).ListBackups(ctx, "project", "instance"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"/iaas/v1/projects/project/servers/server",
+		"/redis/v1/projects/project/instances/instance/backups",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("requested paths = %v, want %v", paths, want)
+	}
+}
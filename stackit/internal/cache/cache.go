@@ -0,0 +1,86 @@
+// Package cache provides a response cache for read-only catalogue and option endpoints
+// which is shared by all resources and data sources of a configured provider
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+)
+
+// Cache holds responses by endpoint and ID, failed requests aren't cached
+// it's safe for concurrent use, as terraform calls resources in parallel
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	mu      sync.Mutex
+	expires time.Time
+	value   interface{}
+}
+
+// caches holds the cache of each configured provider client
+var caches sync.Map
+
+// now is replaced in tests
+var now = time.Now
+
+// For returns the cache of the given provider client
+func For(c *services.Services) *Cache {
+	v, _ := caches.LoadOrStore(c, &Cache{entries: map[string]*entry{}})
+	return v.(*Cache)
+}
+
+// Release drops the cache of the given provider client
+// it's called when the provider is configured again, as the previous client isn't used anymore
+func Release(c *services.Services) {
+	caches.Delete(c)
+}
+
+// Get returns the cached response of the endpoint for the given ID or calls fetch to load it
+// concurrent calls for the same endpoint and ID wait for the first fetch
+// a nil cache always calls fetch
+func Get[T any](c *Cache, e Endpoint, id string, fetch func() (T, error)) (T, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	en := c.entry(e.key(id))
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	if en.value != nil && now().Before(en.expires) {
+		return en.value.(T), nil
+	}
+	v, err := fetch()
+	if err != nil {
+		return v, err
+	}
+	en.value = v
+	en.expires = now().Add(e.TTL)
+	return v, nil
+}
+
+// Invalidate removes the cached response of the endpoint for the given ID
+func (c *Cache) Invalidate(e Endpoint, id string) {
+	if c == nil {
+		return
+	}
+	en := c.entry(e.key(id))
+	en.mu.Lock()
+	defer en.mu.Unlock()
+	en.value = nil
+}
+
+func (c *Cache) entry(key string) *entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	en, ok := c.entries[key]
+	if !ok {
+		en = &entry{}
+		c.entries[key] = en
+	}
+	return en
+}
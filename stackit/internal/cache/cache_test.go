@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+var test = Endpoint{Name: "test", TTL: time.Minute}
+
+func TestGet(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	c := For(&services.Services{})
+	calls := 0
+	var mu sync.Mutex
+	fetch := func() (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return "value", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := Get(c, test, "key", fetch); err != nil || v != "value" {
+				t.Errorf("Get() = %v, %v", v, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected 1 fetch, got %d", calls)
+	}
+
+	// failed requests are retried
+	failed := 0
+	for i := 0; i < 2; i++ {
+		_, err := Get(c, test, "failing", func() (string, error) {
+			failed++
+			return "", errors.New("failed")
+		})
+		if err == nil {
+			t.Error("expected error")
+		}
+	}
+	if failed != 2 {
+		t.Errorf("expected 2 fetches, got %d", failed)
+	}
+
+	// caches aren't shared between providers
+	other := For(&services.Services{})
+	if other == c {
+		t.Error("expected a separate cache per provider client")
+	}
+}
+
+func TestGetExpiry(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	current := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+	defer func() { now = time.Now }()
+
+	c := For(&services.Services{})
+	calls := 0
+	fetch := func() (int, error) {
+		calls++
+		return calls, nil
+	}
+
+	tests := []struct {
+		name    string
+		advance time.Duration
+		want    int
+	}{
+		{"first fetch", 0, 1},
+		{"cached", 30 * time.Second, 1},
+		{"expired", time.Minute, 2},
+		{"cached again", 59 * time.Second, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current = current.Add(tt.advance)
+			if got, _ := Get(c, test, "key", fetch); got != tt.want {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// other IDs of the endpoint are cached separately
+	if got, _ := Get(c, test, "other", fetch); got != 3 {
+		t.Errorf("Get() = %v, want %v", got, 3)
+	}
+
+	c.Invalidate(test, "key")
+	if got, _ := Get(c, test, "key", fetch); got != 4 {
+		t.Errorf("Get() after Invalidate = %v, want %v", got, 4)
+	}
+
+	// a nil cache always fetches
+	var nc *Cache
+	nc.Invalidate(test, "key")
+	if got, _ := Get(nc, test, "key", fetch); got != 5 {
+		t.Errorf("Get() on nil cache = %v, want %v", got, 5)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	client := &services.Services{}
+	c := For(client)
+	if _, err := Get(c, test, "key", func() (string, error) { return "value", nil }); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	Release(client)
+	if _, ok := caches.Load(client); ok {
+		t.Error("expected the cache to be released")
+	}
+	if For(client) == c {
+		t.Error("expected a new cache after release")
+	}
+	Release(client)
+}
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Endpoint is a cached endpoint and how long its responses are kept
+type Endpoint struct {
+	Name string
+	TTL  time.Duration
+}
+
+func (e Endpoint) key(id string) string {
+	return e.Name + "/" + id
+}
+
+var (
+	// catalogue endpoints, which only change with new releases of a service
+	KubernetesProviderOptions = Endpoint{Name: "kubernetes.provider-options", TTL: 30 * time.Minute}
+	ArgusPlans                = Endpoint{Name: "argus.plans", TTL: 30 * time.Minute}
+	DataServicesOfferings     = Endpoint{Name: "data-services.offerings", TTL: 10 * time.Minute}
+	PostgresFlexVersions      = Endpoint{Name: "postgres-flex.versions", TTL: 10 * time.Minute}
+	PostgresFlexFlavors       = Endpoint{Name: "postgres-flex.flavors", TTL: 10 * time.Minute}
+	PostgresFlexStorages      = Endpoint{Name: "postgres-flex.storages", TTL: 10 * time.Minute}
+	MongoDBFlexVersions       = Endpoint{Name: "mongodb-flex.versions", TTL: 10 * time.Minute}
+	MongoDBFlexFlavors        = Endpoint{Name: "mongodb-flex.flavors", TTL: 10 * time.Minute}
+	MongoDBFlexStorages       = Endpoint{Name: "mongodb-flex.storages", TTL: 10 * time.Minute}
+
+	// service enablement of a project, only successful enablements are cached
+	ServiceEnablement = Endpoint{Name: "service-enablement", TTL: 5 * time.Minute}
+)
+
+// DataServicesOfferingsOf returns the offerings of a data service in the project
+// service is the name of the data service, as each service has its own client
+func DataServicesOfferingsOf(ctx context.Context, c *Cache, diags *diag.Diagnostics, client *dataservices.ClientWithResponses, service, projectID string) ([]offerings.Offering, error) {
+	return Get(c, DataServicesOfferings, service+"/"+projectID, func() ([]offerings.Offering, error) {
+		res, err := client.Offerings.List(ctx, projectID)
+		if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
+			return nil, agg
+		}
+		return res.JSON200.Offerings, nil
+	})
+}
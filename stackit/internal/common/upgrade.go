@@ -0,0 +1,135 @@
+package common
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PriorSchema returns the schema of a previous version
+// based on the given schema, with the added attributes and without the removed ones
+func PriorSchema(s schema.Schema, added map[string]schema.Attribute, removed ...string) *schema.Schema {
+	attrs := map[string]schema.Attribute{}
+	for k, v := range s.Attributes {
+		attrs[k] = v
+	}
+	for _, k := range removed {
+		delete(attrs, k)
+	}
+	for k, v := range added {
+		attrs[k] = v
+	}
+	return &schema.Schema{
+		Attributes: attrs,
+		Blocks:     s.Blocks,
+	}
+}
+
+// UpgradeState copies the prior state into the current schema
+// migrate can modify the prior attribute values before they're copied,
+// attributes which are no longer in the schema are dropped and new attributes are null
+func UpgradeState(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse, migrate func(attrs map[string]tftypes.Value) error) {
+	if req.State == nil {
+		resp.Diagnostics.AddError("failed to upgrade state", "prior state is missing")
+		return
+	}
+
+	attrs := map[string]tftypes.Value{}
+	if err := req.State.Raw.As(&attrs); err != nil {
+		resp.Diagnostics.AddError("failed to read prior state", err.Error())
+		return
+	}
+	if migrate != nil {
+		if err := migrate(attrs); err != nil {
+			resp.Diagnostics.AddError("failed to migrate prior state", err.Error())
+			return
+		}
+	}
+
+	t, ok := resp.State.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		resp.Diagnostics.AddError("failed to upgrade state", "unexpected schema type")
+		return
+	}
+	values := map[string]tftypes.Value{}
+	for name, at := range t.AttributeTypes {
+		v, ok := attrs[name]
+		if !ok {
+			values[name] = tftypes.NewValue(at, nil)
+			continue
+		}
+		if !v.Type().Equal(at) {
+			resp.Diagnostics.AddError("failed to upgrade state", fmt.Sprintf("attribute %s changed its type from %s to %s", name, v.Type(), at))
+			return
+		}
+		values[name] = v
+	}
+	resp.State.Raw = tftypes.NewValue(t, values)
+}
+
+// MoveAttribute moves the value of `from` to `to` unless `to` is already set
+func MoveAttribute(attrs map[string]tftypes.Value, from, to string) {
+	v, ok := attrs[from]
+	if !ok {
+		return
+	}
+	delete(attrs, from)
+	if current, ok := attrs[to]; ok && current.IsKnown() && !current.IsNull() {
+		return
+	}
+	attrs[to] = v
+}
+
+// MoveAttributeUpgrader returns a state upgrader from the previous schema version,
+// which had the attribute `from` instead of `to`, e.g. a deprecated attribute which was renamed
+func MoveAttributeUpgrader(s schema.Schema, from, to string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: PriorSchema(s, map[string]schema.Attribute{
+			from: s.Attributes[to],
+		}),
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			UpgradeState(ctx, req, resp, func(attrs map[string]tftypes.Value) error {
+				MoveAttribute(attrs, from, to)
+				return nil
+			})
+		},
+	}
+}
+
+// ApplyStateUpgrade runs the state upgrader of the given version
+// against a JSON state fixture written by that version, the same way terraform does
+func ApplyStateUpgrade(ctx context.Context, r resource.ResourceWithUpgradeState, version int64, fixture string) (tfsdk.State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok || upgrader.PriorSchema == nil {
+		diags.AddError("missing state upgrader", fmt.Sprintf("no state upgrader with prior schema for version %d", version))
+		return tfsdk.State{}, diags
+	}
+
+	raw, err := (&tfprotov6.RawState{JSON: []byte(fixture)}).Unmarshal(upgrader.PriorSchema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("failed to read state fixture", err.Error())
+		return tfsdk.State{}, diags
+	}
+
+	sr := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, sr)
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: sr.Schema,
+		},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Schema: *upgrader.PriorSchema,
+			Raw:    raw,
+		},
+	}, resp)
+	return resp.State, resp.Diagnostics
+}
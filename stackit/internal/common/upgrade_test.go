@@ -0,0 +1,68 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestMoveAttributeUpgrader(t *testing.T) {
+	// don't run during acceptance tests
+	if ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	s := schema.Schema{
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id":         schema.StringAttribute{Computed: true},
+			"project_id": schema.StringAttribute{Required: true},
+		},
+	}
+	upgrader := MoveAttributeUpgrader(s, "old_project_id", "project_id")
+
+	tests := []struct {
+		name    string
+		fixture string
+		want    types.String
+	}{
+		{"moved", `{"id":"id","old_project_id":"old","project_id":null}`, types.StringValue("old")},
+		{"already set", `{"id":"id","old_project_id":"old","project_id":"new"}`, types.StringValue("new")},
+		{"not set", `{"id":"id","old_project_id":null,"project_id":null}`, types.StringNull()},
+		{"missing", `{"id":"id","project_id":"new"}`, types.StringValue("new")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := (&tfprotov6.RawState{JSON: []byte(tt.fixture)}).Unmarshal(upgrader.PriorSchema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("failed to read state fixture: %v", err)
+			}
+
+			resp := &resource.UpgradeStateResponse{
+				State: tfsdk.State{Schema: s},
+			}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+				State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: raw},
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("StateUpgrader() diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got types.String
+			if diags := resp.State.GetAttribute(ctx, path.Root("project_id"), &got); diags.HasError() {
+				t.Fatalf("failed to get project_id: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("project_id = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/dsa"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// backupStatusSucceeded is the status of a successful backup
+const backupStatusSucceeded = "succeeded"
+
+// Read - lifecycle function
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Backups
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := d.client.ListBackups(ctx, config.ProjectID.ValueString(), config.InstanceID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed to list backups", agg.Error())
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s,%s", config.ProjectID.ValueString(), config.InstanceID.ValueString()))
+	config.Backups, config.LatestBackupID = toBackups(res.Result.InstanceBackups)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// toBackups sorts the backups from newest to oldest
+// backup IDs are increasing, which is more reliable than comparing timestamps
+func toBackups(list []dsa.Backup) ([]Backup, types.Int64) {
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].ID > list[j].ID
+	})
+
+	latest := types.Int64Null()
+	backups := []Backup{}
+	for _, b := range list {
+		backup := Backup{
+			ID:           types.Int64Value(b.ID),
+			Status:       types.StringValue(b.Status),
+			Size:         types.Int64Null(),
+			Downloadable: types.BoolNull(),
+			TriggeredAt:  types.StringNull(),
+			FinishedAt:   types.StringValue(b.FinishedAt),
+		}
+		if b.Size != nil {
+			backup.Size = types.Int64Value(*b.Size)
+		}
+		if b.Downloadable != nil {
+			backup.Downloadable = types.BoolValue(*b.Downloadable)
+		}
+		if b.TriggeredAt != nil {
+			backup.TriggeredAt = types.StringValue(*b.TriggeredAt)
+		}
+		if latest.IsNull() && b.Status == backupStatusSucceeded {
+			latest = types.Int64Value(b.ID)
+		}
+		backups = append(backups, backup)
+	}
+	return backups, latest
+}
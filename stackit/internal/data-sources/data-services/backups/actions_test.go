@@ -0,0 +1,38 @@
+package backups
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/dsa"
+)
+
+func Test_toBackups(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	size := int64(1024)
+	list := []dsa.Backup{
+		{ID: 1, Status: "succeeded", FinishedAt: "2023-05-01T00:00:00Z", Size: &size},
+		{ID: 3, Status: "failed", FinishedAt: "2023-05-03T00:00:00Z"},
+		{ID: 2, Status: "succeeded", FinishedAt: "2023-05-02T00:00:00Z"},
+	}
+
+	got, latest := toBackups(list)
+	if latest.ValueInt64() != 2 {
+		t.Errorf("latest = %v, want 2", latest)
+	}
+	if len(got) != 3 || got[0].ID.ValueInt64() != 3 || got[2].ID.ValueInt64() != 1 {
+		t.Fatalf("toBackups() = %+v", got)
+	}
+	if got[2].Size.ValueInt64() != size || !got[0].Size.IsNull() {
+		t.Errorf("unexpected size")
+	}
+
+	if _, latest := toBackups(nil); !latest.IsNull() {
+		t.Errorf("latest = %v, want null", latest)
+	}
+}
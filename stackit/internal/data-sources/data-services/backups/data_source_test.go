@@ -0,0 +1,59 @@
+package backups_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_DataSourceMariaDBBackups(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mariadb_instance.example", "backup_schedule", "0 2 * * *"),
+					resource.TestCheckResourceAttrPair("data.stackit_mariadb_backups.example", "instance_id", "stackit_mariadb_instance.example", "id"),
+					resource.TestCheckResourceAttrSet("data.stackit_mariadb_backups.example", "id"),
+					resource.TestCheckResourceAttrSet("data.stackit_mariadb_backups.example", "backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_mariadb_instance" "example" {
+		name            = "%s"
+		project_id      = "%s"
+		backup_schedule = "0 2 * * *"
+	}
+
+	data "stackit_mariadb_backups" "example" {
+		project_id  = stackit_mariadb_instance.example.project_id
+		instance_id = stackit_mariadb_instance.example.id
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
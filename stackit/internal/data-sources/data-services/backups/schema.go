@@ -0,0 +1,98 @@
+package backups
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Backups is the schema model
+type Backups struct {
+	ID             types.String `tfsdk:"id"`
+	ProjectID      types.String `tfsdk:"project_id"`
+	InstanceID     types.String `tfsdk:"instance_id"`
+	LatestBackupID types.Int64  `tfsdk:"latest_backup_id"`
+	Backups        []Backup     `tfsdk:"backups"`
+}
+
+// Backup is a single instance backup
+type Backup struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Status       types.String `tfsdk:"status"`
+	Size         types.Int64  `tfsdk:"size"`
+	Downloadable types.Bool   `tfsdk:"downloadable"`
+	TriggeredAt  types.String `tfsdk:"triggered_at"`
+	FinishedAt   types.String `tfsdk:"finished_at"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for the backups of a %s instance\n%s",
+			d.service.Display(),
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The instance ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+			"latest_backup_id": schema.Int64Attribute{
+				Description: "the ID of the most recent successful backup",
+				Computed:    true,
+			},
+			"backups": schema.ListNestedAttribute{
+				Description: "the backups of the instance, ordered from newest to oldest",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Description: "the backup ID",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "the backup status",
+							Computed:    true,
+						},
+						"size": schema.Int64Attribute{
+							Description: "the backup size in bytes",
+							Computed:    true,
+						},
+						"downloadable": schema.BoolAttribute{
+							Description: "is true if the backup can be downloaded",
+							Computed:    true,
+						},
+						"triggered_at": schema.StringAttribute{
+							Description: "the time the backup was triggered",
+							Computed:    true,
+						},
+						"finished_at": schema.StringAttribute{
+							Description: "the time the backup finished",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
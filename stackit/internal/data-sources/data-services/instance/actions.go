@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -51,13 +52,14 @@ func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp
 	// set found instance
 	instance := list.Instances[found]
 
-	ores, err := d.client.Offerings.List(ctx, config.ProjectID.ValueString())
-	if agg := common.Validate(&resp.Diagnostics, ores, err, "JSON200"); agg != nil {
-		resp.Diagnostics.AddError("failed to get offerings", agg.Error())
+	projectID := config.ProjectID.ValueString()
+	offers, err := cache.DataServicesOfferingsOf(ctx, d.cache, &resp.Diagnostics, d.client, string(d.service), projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get offerings", err.Error())
 		return
 	}
 
-	for _, offer := range ores.JSON200.Offerings {
+	for _, offer := range offers {
 		for _, p := range offer.Plans {
 			if p.ID != instance.PlanID {
 				continue
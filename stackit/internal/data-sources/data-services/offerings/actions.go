@@ -0,0 +1,59 @@
+package offerings
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Read - lifecycle function
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Offerings
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := config.ProjectID.ValueString()
+	list, err := cache.DataServicesOfferingsOf(ctx, d.cache, &resp.Diagnostics, d.client, string(d.service), projectID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get offerings", err.Error())
+		return
+	}
+
+	config.ID = config.ProjectID
+	config.Offerings, config.LatestVersion = toOfferings(list)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func toOfferings(offers []offerings.Offering) ([]Offering, types.String) {
+	latest := types.StringNull()
+	list := []Offering{}
+	for _, o := range offers {
+		plans := []Plan{}
+		for _, p := range o.Plans {
+			plans = append(plans, Plan{
+				ID:          types.StringValue(p.ID),
+				Name:        types.StringValue(p.Name),
+				Description: types.StringValue(p.Description),
+				Free:        types.BoolValue(p.Free),
+			})
+		}
+		list = append(list, Offering{
+			Version:          types.StringValue(o.Version),
+			Name:             types.StringValue(o.Name),
+			Description:      types.StringValue(o.Description),
+			DocumentationURL: types.StringValue(o.DocumentationUrl),
+			Latest:           types.BoolValue(o.Latest),
+			Plans:            plans,
+		})
+		if o.Latest {
+			latest = types.StringValue(o.Version)
+		}
+	}
+	return list, latest
+}
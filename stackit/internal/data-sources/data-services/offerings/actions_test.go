@@ -0,0 +1,44 @@
+package offerings
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func Test_toOfferings(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	offers := []offerings.Offering{
+		{Version: "6", Name: "redis", Plans: []offerings.Plan{
+			{ID: "1", Name: "stackit-redis-1.2.10-single", Description: "single", Free: false},
+		}},
+		{Version: "7", Name: "redis", Latest: true, Plans: []offerings.Plan{
+			{ID: "2", Name: "stackit-redis-1.4.10-single", Description: "single", Free: true},
+			{ID: "3", Name: "stackit-redis-1.4.10-replica", Description: "replica"},
+		}},
+	}
+
+	got, latest := toOfferings(offers)
+	if latest.ValueString() != "7" {
+		t.Errorf("latest = %v, want 7", latest)
+	}
+	if len(got) != 2 || len(got[1].Plans) != 2 {
+		t.Fatalf("toOfferings() = %+v", got)
+	}
+	if p := got[1].Plans[0]; p.ID.ValueString() != "2" || !p.Free.ValueBool() {
+		t.Errorf("plan = %+v", p)
+	}
+	if !got[1].Latest.ValueBool() || got[0].Latest.ValueBool() {
+		t.Error("unexpected latest marker")
+	}
+
+	if _, latest := toOfferings(nil); !latest.IsNull() {
+		t.Errorf("latest = %v, want null", latest)
+	}
+}
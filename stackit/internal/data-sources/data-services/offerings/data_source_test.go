@@ -0,0 +1,53 @@
+package offerings_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_DataSourceOfferings(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	for _, service := range []string{"logme", "mariadb", "opensearch", "postgres", "rabbitmq", "redis"} {
+		name := fmt.Sprintf("data.stackit_%s_offerings.example", service)
+		resource.Test(t, resource.TestCase{
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: config(service),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(name, "id", common.GetAcceptanceTestsProjectID()),
+						resource.TestCheckResourceAttrSet(name, "latest_version"),
+						resource.TestCheckResourceAttrSet(name, "offerings.0.version"),
+						resource.TestCheckResourceAttrSet(name, "offerings.0.plans.0.id"),
+						resource.TestCheckResourceAttrSet(name, "offerings.0.plans.0.name"),
+					),
+				},
+			},
+		})
+	}
+}
+
+func config(service string) string {
+	return fmt.Sprintf(`
+	data "stackit_%s_offerings" "example" {
+		project_id = "%s"
+	}
+	`,
+		service,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
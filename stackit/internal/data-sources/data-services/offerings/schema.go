@@ -0,0 +1,118 @@
+package offerings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Offerings is the schema model
+type Offerings struct {
+	ID            types.String `tfsdk:"id"`
+	ProjectID     types.String `tfsdk:"project_id"`
+	LatestVersion types.String `tfsdk:"latest_version"`
+	Offerings     []Offering   `tfsdk:"offerings"`
+}
+
+// Offering is a single service version
+type Offering struct {
+	Version          types.String `tfsdk:"version"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	DocumentationURL types.String `tfsdk:"documentation_url"`
+	Latest           types.Bool   `tfsdk:"latest"`
+	Plans            []Plan       `tfsdk:"plans"`
+}
+
+// Plan is a plan of an offering
+type Plan struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Free        types.Bool   `tfsdk:"free"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for the available %s versions and plans\n%s",
+			d.service.Display(),
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+			},
+			"latest_version": schema.StringAttribute{
+				Description: "the latest available version",
+				Computed:    true,
+			},
+			"offerings": schema.ListNestedAttribute{
+				Description: fmt.Sprintf("the available %s versions", d.service.Display()),
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: fmt.Sprintf("%s version, used in the `version` attribute of `stackit_%s_instance`", d.service.Display(), d.service),
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "the offering name",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "the offering description",
+							Computed:    true,
+						},
+						"documentation_url": schema.StringAttribute{
+							Description: "URL of the documentation",
+							Computed:    true,
+						},
+						"latest": schema.BoolAttribute{
+							Description: "is true for the latest version",
+							Computed:    true,
+						},
+						"plans": schema.ListNestedAttribute{
+							Description: "the plans available for the version",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "the plan ID",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: fmt.Sprintf("the plan name, used in the `plan` attribute of `stackit_%s_instance`", d.service),
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "the plan description",
+										Computed:    true,
+									},
+									"free": schema.BoolAttribute{
+										Description: "is true if the plan is free of charge",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package object
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Read - lifecycle function
+func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Object
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := s3.New(config.BucketURL.ValueString(), config.Region.ValueString(), config.AccessKey.ValueString(), config.SecretAccessKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to initialize S3 client", err.Error())
+		return
+	}
+
+	o, err := c.GetObject(ctx, config.Key.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading object", err.Error())
+		return
+	}
+
+	config.ID = config.Key
+	config.ContentType = types.StringValue(o.ContentType)
+	config.ContentLength = types.Int64Value(o.ContentLength)
+	config.ETag = types.StringValue(o.ETag)
+	config.LastModified = types.StringValue(o.LastModified.Format(time.RFC3339))
+	config.Metadata = o.Metadata
+	config.Content = types.StringNull()
+	if utf8.Valid(o.Body) {
+		config.Content = types.StringValue(string(o.Body))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,24 @@
+package object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+// New returns a new configured data source
+func New() datasource.DataSource {
+	return &DataSource{}
+}
+
+// DataSource is the exported data source
+// objects are read through the S3 API of the bucket
+// and don't require the STACKIT API client
+type DataSource struct{}
+
+var _ = datasource.DataSource(&DataSource{})
+
+// Metadata returns data resource metadata
+func (d *DataSource) Metadata(_ context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	res.TypeName = "stackit_object_storage_object"
+}
@@ -0,0 +1,75 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ObjectStorageObject(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stackit_object_storage_object.example", "id", "index.html"),
+					resource.TestCheckResourceAttr("data.stackit_object_storage_object.example", "content", "hello"),
+					resource.TestCheckResourceAttr("data.stackit_object_storage_object.example", "content_length", "5"),
+					resource.TestCheckResourceAttr("data.stackit_object_storage_object.example", "content_type", "text/plain"),
+					resource.TestCheckResourceAttrPair("data.stackit_object_storage_object.example", "etag", "stackit_object_storage_object.example", "etag"),
+					resource.TestCheckResourceAttrSet("data.stackit_object_storage_object.example", "last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_object_storage_credential" "example" {
+	project_id = "%s"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+	project_id = stackit_object_storage_credential.example.project_id
+	name       = "%s"
+}
+
+resource "stackit_object_storage_object" "example" {
+	bucket_url        = stackit_object_storage_bucket.example.path_style_url
+	access_key        = stackit_object_storage_credential.example.access_key
+	secret_access_key = stackit_object_storage_credential.example.secret_access_key
+	key               = "index.html"
+	content           = "hello"
+	content_type      = "text/plain"
+}
+
+data "stackit_object_storage_object" "example" {
+	bucket_url        = stackit_object_storage_object.example.bucket_url
+	access_key        = stackit_object_storage_credential.example.access_key
+	secret_access_key = stackit_object_storage_credential.example.secret_access_key
+	key               = stackit_object_storage_object.example.key
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -0,0 +1,97 @@
+package object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Object is the schema model
+type Object struct {
+	ID              types.String      `tfsdk:"id"`
+	BucketURL       types.String      `tfsdk:"bucket_url"`
+	Region          types.String      `tfsdk:"region"`
+	AccessKey       types.String      `tfsdk:"access_key"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	Key             types.String      `tfsdk:"key"`
+	Content         types.String      `tfsdk:"content"`
+	ContentType     types.String      `tfsdk:"content_type"`
+	ContentLength   types.Int64       `tfsdk:"content_length"`
+	Metadata        map[string]string `tfsdk:"metadata"`
+	ETag            types.String      `tfsdk:"etag"`
+	LastModified    types.String      `tfsdk:"last_modified"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for objects in an Object Storage bucket.\n\n" +
+			"The object is read via the S3 API of the bucket using the keys of a `stackit_object_storage_credential`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (the object key)",
+				Computed:    true,
+			},
+
+			"bucket_url": schema.StringAttribute{
+				Description: "the path style (`path_style_url`) or host style (`host_style_url`) URL of the bucket",
+				Required:    true,
+			},
+
+			"region": schema.StringAttribute{
+				Description: "the region of the bucket, defaults to `eu01`",
+				Optional:    true,
+			},
+
+			"access_key": schema.StringAttribute{
+				Description: "access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"key": schema.StringAttribute{
+				Description: "the object key",
+				Required:    true,
+			},
+
+			"content": schema.StringAttribute{
+				Description: "the object's content. Only set for UTF-8 encoded content, binary objects are not downloaded into the state.",
+				Computed:    true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Description: "the MIME type of the object",
+				Computed:    true,
+			},
+
+			"content_length": schema.Int64Attribute{
+				Description: "the size of the object in bytes",
+				Computed:    true,
+			},
+
+			"metadata": schema.MapAttribute{
+				Description: "user defined metadata",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+
+			"etag": schema.StringAttribute{
+				Description: "the ETag of the object",
+				Computed:    true,
+			},
+
+			"last_modified": schema.StringAttribute{
+				Description: "the last modification time (RFC3339)",
+				Computed:    true,
+			},
+		},
+	}
+}
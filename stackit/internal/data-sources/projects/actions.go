@@ -0,0 +1,98 @@
+package projects
+
+import (
+	"context"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pageLimit = 100
+
+// Read - lifecycle function
+func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var p Projects
+	diags := req.Config.Get(ctx, &p)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, err := newFilter(p)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid filter", err.Error())
+		return
+	}
+
+	parent := p.ParentContainerID.ValueString()
+	params := &rmv2.ListParams{
+		ContainerParentID: &parent,
+	}
+	if v := p.CreatedAfter.ValueString(); v != "" {
+		params.CreationTimeStart = &v
+	}
+
+	p.Projects = []Project{}
+	limit := rmv2.Limit(pageLimit)
+	offset := rmv2.Offset(0)
+	for {
+		params.Limit = &limit
+		params.Offset = &offset
+		res, err := d.client.ResourceManagement.List(ctx, params)
+		if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+			resp.Diagnostics.AddError("failed listing projects", agg.Error())
+			return
+		}
+
+		for _, item := range res.JSON200.Items {
+			if !f.matches(item) {
+				continue
+			}
+			p.Projects = append(p.Projects, toProject(item))
+		}
+
+		if len(res.JSON200.Items) < pageLimit {
+			break
+		}
+		offset += rmv2.Offset(len(res.JSON200.Items))
+	}
+
+	p.ID = types.StringValue(parent)
+	diags = resp.State.Set(ctx, &p)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func toProject(item rmv2.ProjectResponse) Project {
+	p := Project{
+		ID:                types.StringValue(item.ProjectID.String()),
+		ContainerID:       types.StringValue(item.ContainerID),
+		ParentContainerID: types.StringValue(item.Parent.ContainerID),
+		Name:              types.StringValue(item.Name),
+		BillingRef:        types.StringNull(),
+		LifecycleState:    types.StringValue(string(item.LifecycleState)),
+		CreationTime:      types.StringValue(item.CreationTime),
+		Labels:            map[string]string{},
+	}
+
+	if item.Labels != nil {
+		l := *item.Labels
+		if v, ok := l["billingReference"]; ok {
+			p.BillingRef = types.StringValue(v)
+		}
+
+		for k, v := range l {
+			p.Labels[k] = v
+		}
+
+		// delete "hidden" labels which are exposed via attribute
+		// to stay consistent with the project data source
+		delete(p.Labels, "billingReference")
+		delete(p.Labels, "scope")
+	}
+	return p
+}
@@ -0,0 +1,90 @@
+package projects_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+const schwarz_container_id = "schwarz-it-kg-WJACUK1"
+
+func TestAcc_ProjectsDataSource(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	billing, ok := os.LookupEnv("ACC_TEST_BILLING_REF")
+	if !ok {
+		t.Skip("Skipping TestAcc_ProjectsDataSource: ACC_TEST_BILLING_REF not specified")
+	}
+
+	user, ok := os.LookupEnv("ACC_TEST_USER_EMAIL")
+	if !ok {
+		t.Skip("Skipping TestAcc_ProjectsDataSource: ACC_TEST_USER_EMAIL not specified")
+	}
+
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := "ODJ AccTest " + suffix
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// filter by name and labels
+			{
+				Config: config(name, user, billing, suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stackit_projects.ex1", "projects.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("stackit_project.example", "id", "data.stackit_projects.ex1", "projects.0.id"),
+					resource.TestCheckTypeSetElemAttrPair("stackit_project.example", "container_id", "data.stackit_projects.ex1", "projects.0.container_id"),
+					resource.TestCheckTypeSetElemAttrPair("stackit_project.example", "billing_ref", "data.stackit_projects.ex1", "projects.0.billing_ref"),
+					resource.TestCheckResourceAttr("data.stackit_projects.ex1", "projects.0.labels.acc", suffix),
+					resource.TestCheckResourceAttr("data.stackit_projects.ex2", "projects.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func config(name, owner, billing, suffix string) string {
+	return fmt.Sprintf(`
+	resource "stackit_project" "example" {
+		name        = "%s"
+		billing_ref = "%s"
+		owner_email = "%s"
+		parent_container_id = "%s"
+		labels = {
+			"acc" = "%s"
+		}
+	}
+
+	data "stackit_projects" "ex1" {
+		depends_on          = [stackit_project.example]
+		parent_container_id = stackit_project.example.parent_container_id
+		name_regex          = "%s$"
+		lifecycle_state     = "ACTIVE"
+		label_selectors     = ["acc=%s", "billingReference"]
+	}
+
+	data "stackit_projects" "ex2" {
+		depends_on          = [stackit_project.example]
+		parent_container_id = stackit_project.example.parent_container_id
+		label_selectors     = ["acc=%s-missing"]
+	}
+	`,
+		name, billing, owner, schwarz_container_id, suffix,
+		suffix, suffix,
+		suffix,
+	)
+}
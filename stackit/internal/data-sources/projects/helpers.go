@@ -0,0 +1,98 @@
+package projects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+)
+
+// selector is a single parsed label selector
+type selector struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+// filter holds the parsed filter attributes
+type filter struct {
+	name      *regexp.Regexp
+	state     string
+	after     *time.Time
+	selectors []selector
+}
+
+func newFilter(p Projects) (filter, error) {
+	f := filter{
+		state: p.LifecycleState.ValueString(),
+	}
+
+	if v := p.NameRegex.ValueString(); v != "" {
+		r, err := regexp.Compile(v)
+		if err != nil {
+			return f, fmt.Errorf("invalid name_regex: %w", err)
+		}
+		f.name = r
+	}
+
+	if v := p.CreatedAfter.ValueString(); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return f, fmt.Errorf("invalid created_after: %w", err)
+		}
+		f.after = &t
+	}
+
+	for _, s := range p.LabelSelectors {
+		sel, err := parseSelector(s)
+		if err != nil {
+			return f, err
+		}
+		f.selectors = append(f.selectors, sel)
+	}
+	return f, nil
+}
+
+func parseSelector(s string) (selector, error) {
+	k, v, hasValue := strings.Cut(s, "=")
+	k = strings.TrimSpace(k)
+	if k == "" {
+		return selector{}, fmt.Errorf("invalid label selector %q, expected `key=value` or `key`", s)
+	}
+	return selector{
+		key:      k,
+		value:    strings.TrimSpace(v),
+		hasValue: hasValue,
+	}, nil
+}
+
+func (f filter) matches(p rmv2.ProjectResponse) bool {
+	if f.name != nil && !f.name.MatchString(p.Name) {
+		return false
+	}
+
+	if f.state != "" && string(p.LifecycleState) != f.state {
+		return false
+	}
+
+	if f.after != nil {
+		created, err := time.Parse(time.RFC3339, p.CreationTime)
+		if err != nil || created.Before(*f.after) {
+			return false
+		}
+	}
+
+	labels := rmv2.ResourceLabels{}
+	if p.Labels != nil {
+		labels = *p.Labels
+	}
+	for _, s := range f.selectors {
+		v, ok := labels[s.key]
+		if !ok || (s.hasValue && v != s.value) {
+			return false
+		}
+	}
+	return true
+}
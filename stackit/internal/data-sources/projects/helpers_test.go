@@ -0,0 +1,54 @@
+package projects
+
+import (
+	"testing"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_filterMatches(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	project := rmv2.ProjectResponse{
+		Name:           "team-a-prod",
+		LifecycleState: rmv2.ACTIVE,
+		CreationTime:   "2023-03-01T10:00:00Z",
+		Labels: &rmv2.ResourceLabels{
+			"billingReference": "ABC-123",
+			"env":              "prod",
+		},
+	}
+	tests := []struct {
+		name string
+		args Projects
+		want bool
+	}{
+		{name: "no filter", args: Projects{}, want: true},
+		{name: "name match", args: Projects{NameRegex: types.StringValue("^team-a-")}, want: true},
+		{name: "name mismatch", args: Projects{NameRegex: types.StringValue("^team-b-")}, want: false},
+		{name: "state match", args: Projects{LifecycleState: types.StringValue("ACTIVE")}, want: true},
+		{name: "state mismatch", args: Projects{LifecycleState: types.StringValue("DELETING")}, want: false},
+		{name: "created before", args: Projects{CreatedAfter: types.StringValue("2023-01-01T00:00:00Z")}, want: true},
+		{name: "created after", args: Projects{CreatedAfter: types.StringValue("2023-04-01T00:00:00Z")}, want: false},
+		{name: "label value", args: Projects{LabelSelectors: []string{"billingReference=ABC-123", "env=prod"}}, want: true},
+		{name: "label value mismatch", args: Projects{LabelSelectors: []string{"env=dev"}}, want: false},
+		{name: "label key", args: Projects{LabelSelectors: []string{"env"}}, want: true},
+		{name: "label key missing", args: Projects{LabelSelectors: []string{"team"}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newFilter(tt.args)
+			if err != nil {
+				t.Fatalf("newFilter() error = %v", err)
+			}
+			if got := f.matches(project); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Projects is the schema model
+type Projects struct {
+	ID                types.String `tfsdk:"id"`
+	ParentContainerID types.String `tfsdk:"parent_container_id"`
+	NameRegex         types.String `tfsdk:"name_regex"`
+	LifecycleState    types.String `tfsdk:"lifecycle_state"`
+	CreatedAfter      types.String `tfsdk:"created_after"`
+	LabelSelectors    []string     `tfsdk:"label_selectors"`
+	Projects          []Project    `tfsdk:"projects"`
+}
+
+// Project is a single matching project
+type Project struct {
+	ID                types.String      `tfsdk:"id"`
+	ContainerID       types.String      `tfsdk:"container_id"`
+	ParentContainerID types.String      `tfsdk:"parent_container_id"`
+	Name              types.String      `tfsdk:"name"`
+	BillingRef        types.String      `tfsdk:"billing_ref"`
+	LifecycleState    types.String      `tfsdk:"lifecycle_state"`
+	CreationTime      types.String      `tfsdk:"creation_time"`
+	Labels            map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for listing the STACKIT projects of a parent container\n%s",
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the data source ID",
+				Computed:    true,
+			},
+
+			"parent_container_id": schema.StringAttribute{
+				Description: "the container ID of the organization or folder the projects belong to",
+				Required:    true,
+			},
+
+			"name_regex": schema.StringAttribute{
+				Description: "only return projects with a name matching this regular expression",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"lifecycle_state": schema.StringAttribute{
+				Description: "only return projects in this lifecycle state. Options: `CREATING`, `ACTIVE`, `INACTIVE`, `DELETING`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(rmv2.CREATING),
+						string(rmv2.ACTIVE),
+						string(rmv2.INACTIVE),
+						string(rmv2.DELETING),
+					),
+				},
+			},
+
+			"created_after": schema.StringAttribute{
+				Description: "only return projects created at or after this RFC3339 timestamp, for example `2023-01-01T00:00:00Z`",
+				Optional:    true,
+			},
+
+			"label_selectors": schema.ListAttribute{
+				Description: "only return projects matching all selectors. A selector is either `key=value` or `key` to match projects which have the label set, for example `billingReference=ABC-123` or `env=prod`",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+
+			"projects": schema.ListNestedAttribute{
+				Description: "the matching projects",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "the project UUID",
+							Computed:    true,
+						},
+						"container_id": schema.StringAttribute{
+							Description: "the project container ID",
+							Computed:    true,
+						},
+						"parent_container_id": schema.StringAttribute{
+							Description: "the project's parent container ID",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "the project name",
+							Computed:    true,
+						},
+						"billing_ref": schema.StringAttribute{
+							Description: "billing reference for cost transparency",
+							Computed:    true,
+						},
+						"lifecycle_state": schema.StringAttribute{
+							Description: "the project's lifecycle state",
+							Computed:    true,
+						},
+						"creation_time": schema.StringAttribute{
+							Description: "the project's creation time",
+							Computed:    true,
+						},
+						"labels": schema.MapAttribute{
+							Description: "the project labels",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
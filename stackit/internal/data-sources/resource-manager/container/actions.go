@@ -0,0 +1,106 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const pageLimit = 100
+
+// folder holds the attributes of a resolved folder
+type folder struct {
+	id          string
+	containerID string
+	name        string
+	labels      *rmv2.ResourceLabels
+}
+
+// Read - lifecycle function
+func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var c Container
+	diags := req.Config.Get(ctx, &c)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	segments := []string{c.Name.ValueString()}
+	if !c.Path.IsNull() {
+		segments = strings.Split(strings.Trim(c.Path.ValueString(), "/"), "/")
+	}
+
+	parent := c.ParentContainerID.ValueString()
+	var f *folder
+	for _, name := range segments {
+		f = d.findChild(ctx, &resp.Diagnostics, parent, name)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		parent = f.containerID
+	}
+
+	c.ID = types.StringValue(f.id)
+	c.ContainerID = types.StringValue(f.containerID)
+	c.Name = types.StringValue(f.name)
+	c.Labels = map[string]string{}
+	if f.labels != nil {
+		c.Labels = *f.labels
+	}
+
+	diags = resp.State.Set(ctx, &c)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findChild returns the folder with the given name directly under the parent container
+func (d DataSource) findChild(ctx context.Context, diags *diag.Diagnostics, parent, name string) *folder {
+	var found []folder
+	limit := rmv2.Limit(pageLimit)
+	offset := rmv2.Offset(0)
+	for {
+		res, err := d.client.ResourceManagement.GetFolders(ctx, &rmv2.GetFoldersParams{
+			ContainerParentID: &parent,
+			Limit:             &limit,
+			Offset:            &offset,
+		})
+		if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
+			diags.AddError("failed listing folders", agg.Error())
+			return nil
+		}
+
+		for _, item := range res.JSON200.Items {
+			if item.Name == name && item.Parent.ContainerID == parent {
+				found = append(found, folder{
+					id:          item.FolderID.String(),
+					containerID: item.ContainerID,
+					name:        item.Name,
+					labels:      item.Labels,
+				})
+			}
+		}
+
+		if len(res.JSON200.Items) < pageLimit {
+			break
+		}
+		offset += rmv2.Offset(len(res.JSON200.Items))
+	}
+
+	switch len(found) {
+	case 0:
+		diags.AddError("folder not found", fmt.Sprintf("couldn't find a folder named %q in container %s", name, parent))
+		return nil
+	case 1:
+		return &found[0]
+	}
+	diags.AddError("folder name is ambiguous", fmt.Sprintf("found %d folders named %q in container %s", len(found), name, parent))
+	return nil
+}
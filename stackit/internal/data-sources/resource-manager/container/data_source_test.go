@@ -0,0 +1,77 @@
+package container_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+const schwarz_container_id = "schwarz-it-kg-WJACUK1"
+
+func TestAcc_ResourceManagerContainerDataSource(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "AccTest " + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_resource_manager_folder.team", "container_id", "data.stackit_resource_manager_container.by_name", "container_id"),
+					resource.TestCheckResourceAttrPair("stackit_resource_manager_folder.team", "id", "data.stackit_resource_manager_container.by_name", "id"),
+					resource.TestCheckResourceAttrPair("stackit_resource_manager_folder.env", "container_id", "data.stackit_resource_manager_container.by_path", "container_id"),
+					resource.TestCheckResourceAttr("data.stackit_resource_manager_container.by_path", "name", "prod"),
+					resource.TestCheckResourceAttr("data.stackit_resource_manager_container.by_path", "labels.env", "prod"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_resource_manager_folder" "team" {
+		name                = "%s"
+		parent_container_id = "%s"
+	}
+
+	resource "stackit_resource_manager_folder" "env" {
+		name                = "prod"
+		parent_container_id = stackit_resource_manager_folder.team.container_id
+		labels = {
+			env = "prod"
+		}
+	}
+
+	data "stackit_resource_manager_container" "by_name" {
+		depends_on          = [stackit_resource_manager_folder.team]
+		parent_container_id = "%s"
+		name                = "%s"
+	}
+
+	data "stackit_resource_manager_container" "by_path" {
+		depends_on          = [stackit_resource_manager_folder.env]
+		parent_container_id = "%s"
+		path                = "%s/prod"
+	}
+	`,
+		name, schwarz_container_id,
+		schwarz_container_id, name,
+		schwarz_container_id, name,
+	)
+}
@@ -0,0 +1,72 @@
+package container
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Container is the schema model
+type Container struct {
+	ID                types.String      `tfsdk:"id"`
+	ContainerID       types.String      `tfsdk:"container_id"`
+	ParentContainerID types.String      `tfsdk:"parent_container_id"`
+	Name              types.String      `tfsdk:"name"`
+	Path              types.String      `tfsdk:"path"`
+	Labels            map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Data source for STACKIT resource manager folders. The folder is resolved either by its `name` directly under `parent_container_id` or by a `/` separated `path` of folder names starting at `parent_container_id`.\n%s",
+			common.EnvironmentInfo(d.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the folder ID",
+				Computed:    true,
+			},
+
+			"parent_container_id": schema.StringAttribute{
+				Description: "the container ID of the organization or folder where the lookup starts",
+				Required:    true,
+			},
+
+			"name": schema.StringAttribute{
+				Description: "the name of a folder directly under `parent_container_id`",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("path")),
+				},
+			},
+
+			"path": schema.StringAttribute{
+				Description: "a path of folder names separated by `/`, for example `business-unit/team/env`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+
+			"container_id": schema.StringAttribute{
+				Description: "the folder container ID",
+				Computed:    true,
+			},
+
+			"labels": schema.MapAttribute{
+				Description: "the folder labels",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/vault"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Read - lifecycle function
+func (d DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config Secret
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := vault.New(config.APIURL.ValueString(), config.Username.ValueString(), config.Password.ValueString())
+	mount, p := config.InstanceID.ValueString(), config.Path.ValueString()
+
+	s, err := c.ReadSecret(ctx, mount, p, config.Version.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading secret", err.Error())
+		return
+	}
+
+	m, err := c.ReadMetadata(ctx, mount, p)
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading secret metadata", err.Error())
+		return
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s,%s", mount, p))
+	config.Version = types.Int64Value(s.Metadata.Version)
+	config.Data = s.StringData()
+	config.CustomMetadata = m.Custom
+	config.CreatedTime = types.StringValue(s.Metadata.CreatedTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,82 @@
+package secret_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_SecretsManagerSecret(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.stackit_secrets_manager_secret.example", "data.password", "secret"),
+					resource.TestCheckResourceAttr("data.stackit_secrets_manager_secret.example", "custom_metadata.owner", "team"),
+					resource.TestCheckResourceAttr("data.stackit_secrets_manager_secret.example", "version", "1"),
+					resource.TestCheckResourceAttrPair("data.stackit_secrets_manager_secret.example", "id", "stackit_secrets_manager_secret.example", "id"),
+					resource.TestCheckResourceAttrSet("data.stackit_secrets_manager_secret.example", "created_time"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_secrets_manager_instance" "example" {
+		project_id         = "%s"
+		name               = "%s"
+	}
+
+	resource "stackit_secrets_manager_user" "example" {
+		project_id         = stackit_secrets_manager_instance.example.project_id
+		instance_id        = stackit_secrets_manager_instance.example.id
+		description        = "test"
+		write_enabled      = true
+	}
+
+	resource "stackit_secrets_manager_secret" "example" {
+		instance_id = stackit_secrets_manager_instance.example.id
+		api_url     = stackit_secrets_manager_instance.example.api_url
+		username    = stackit_secrets_manager_user.example.username
+		password    = stackit_secrets_manager_user.example.password
+		path        = "databases/orders"
+		data = {
+			password = "secret"
+		}
+		custom_metadata = {
+			owner = "team"
+		}
+	}
+
+	data "stackit_secrets_manager_secret" "example" {
+		instance_id = stackit_secrets_manager_secret.example.instance_id
+		api_url     = stackit_secrets_manager_secret.example.api_url
+		username    = stackit_secrets_manager_secret.example.username
+		password    = stackit_secrets_manager_secret.example.password
+		path        = stackit_secrets_manager_secret.example.path
+	}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
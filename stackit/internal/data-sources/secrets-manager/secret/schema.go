@@ -0,0 +1,96 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret is the schema model
+type Secret struct {
+	ID             types.String      `tfsdk:"id"`
+	InstanceID     types.String      `tfsdk:"instance_id"`
+	APIURL         types.String      `tfsdk:"api_url"`
+	Username       types.String      `tfsdk:"username"`
+	Password       types.String      `tfsdk:"password"`
+	Path           types.String      `tfsdk:"path"`
+	Version        types.Int64       `tfsdk:"version"`
+	Data           map[string]string `tfsdk:"data"`
+	CustomMetadata map[string]string `tfsdk:"custom_metadata"`
+	CreatedTime    types.String      `tfsdk:"created_time"`
+}
+
+// Schema returns the terraform schema structure
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for secrets of a Secrets Manager instance.\n\n" +
+			"The secret is read via the Vault compatible API of the instance using the credentials of a `stackit_secrets_manager_user`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (`instance_id,path`)",
+				Computed:    true,
+			},
+
+			"instance_id": schema.StringAttribute{
+				Description: "the Secrets Manager instance ID, which is the mount path of the secrets engine",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+
+			"api_url": schema.StringAttribute{
+				Description: "the API URL of the instance (`stackit_secrets_manager_instance.api_url`)",
+				Required:    true,
+			},
+
+			"username": schema.StringAttribute{
+				Description: "username of a `stackit_secrets_manager_user`",
+				Required:    true,
+			},
+
+			"password": schema.StringAttribute{
+				Description: "password of a `stackit_secrets_manager_user`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"path": schema.StringAttribute{
+				Description: "the secret path, e.g. `databases/orders`",
+				Required:    true,
+			},
+
+			"version": schema.Int64Attribute{
+				Description: "the secret version to read. If not set, the latest version is read.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"data": schema.MapAttribute{
+				Description: "the secret's key value pairs. Non string values are JSON encoded.",
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+
+			"custom_metadata": schema.MapAttribute{
+				Description: "custom metadata of the secret",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+
+			"created_time": schema.StringAttribute{
+				Description: "the creation time of the version",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package dsa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+const (
+	RestoreStatusFinished = "finished"
+	RestoreStatusFailed   = "failed"
+)
+
+// Backup is an instance backup
+type Backup struct {
+	ID           int64   `json:"id"`
+	Status       string  `json:"status"`
+	Size         *int64  `json:"size,omitempty"`
+	Downloadable *bool   `json:"downloadable,omitempty"`
+	TriggeredAt  *string `json:"triggered_at,omitempty"`
+	FinishedAt   string  `json:"finished_at"`
+}
+
+// Backups is the list backups response
+type Backups struct {
+	InstanceBackups []Backup `json:"instanceBackups"`
+}
+
+// Restore is a restore of an instance backup
+type Restore struct {
+	ID          int64   `json:"id"`
+	BackupID    int64   `json:"backup_id"`
+	Status      string  `json:"status"`
+	TriggeredAt *string `json:"triggered_at,omitempty"`
+	FinishedAt  string  `json:"finished_at"`
+}
+
+// Restores is the list restores response
+type Restores struct {
+	InstanceRestores []Restore `json:"instanceRestores"`
+}
+
+// TriggerRestoreResponse is the response of a triggered restore
+type TriggerRestoreResponse struct {
+	ID int64 `json:"id"`
+}
+
+// ListBackups lists the backups of an instance
+func (c *Client) ListBackups(ctx context.Context, projectID, instanceID string) (*Response[Backups], error) {
+	return do[Backups](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/instances/%s/backups", projectID, instanceID), nil)
+}
+
+// TriggerRestore restores an instance from the given backup
+func (c *Client) TriggerRestore(ctx context.Context, projectID, instanceID string, backupID int64) (*Response[TriggerRestoreResponse], error) {
+	return do[TriggerRestoreResponse](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/instances/%s/backups/%d/restore", projectID, instanceID, backupID), nil)
+}
+
+// ListRestores lists the restores of an instance
+func (c *Client) ListRestores(ctx context.Context, projectID, instanceID string) (*Response[Restores], error) {
+	return do[Restores](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/instances/%s/restores", projectID, instanceID), nil)
+}
+
+// GetRestore returns a single restore, the result is nil if it wasn't found
+func (c *Client) GetRestore(ctx context.Context, projectID, instanceID string, restoreID int64) (*Restore, error) {
+	resp, err := c.ListRestores(ctx, projectID, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+	if resp.Result == nil {
+		return nil, nil
+	}
+	for _, r := range resp.Result.InstanceRestores {
+		if r.ID == restoreID {
+			r := r
+			return &r, nil
+		}
+	}
+	return nil, nil
+}
+
+// RestoreWaitHandler waits for the restore to finish and returns it
+func (c *Client) RestoreWaitHandler(ctx context.Context, projectID, instanceID string, restoreID int64) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.ListRestores(ctx, projectID, instanceID)
+		if err != nil {
+			return nil, false, err
+		}
+		if resp.Error != nil {
+			if resp.StatusCode() >= http.StatusInternalServerError {
+				// retry on server errors
+				return nil, false, nil
+			}
+			return nil, false, resp.Error
+		}
+		if resp.Result == nil {
+			return nil, false, nil
+		}
+
+		for _, r := range resp.Result.InstanceRestores {
+			if r.ID != restoreID {
+				continue
+			}
+			switch r.Status {
+			case RestoreStatusFinished:
+				return r, true, nil
+			case RestoreStatusFailed:
+				return nil, false, errors.New("restore failed")
+			}
+		}
+		return nil, false, nil
+	})
+}
@@ -0,0 +1,99 @@
+// Package dsa provides a minimal client for the data-services API endpoints
+// which are not covered by the community client yet (backups, restores)
+package dsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/contracts"
+	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+)
+
+// Client is the data-services client of a single service
+type Client struct {
+	server string
+	client contracts.BaseClientInterface
+}
+
+// New returns a new client using the base URL and HTTP client of the configured service client
+// which follows the provider's region and endpoints configuration
+func New(c *dataservices.ClientWithResponses) *Client {
+	return &Client{
+		server: strings.TrimSuffix(c.Client.Server, "/"),
+		client: c.Client.Client,
+	}
+}
+
+// Response is a generic API response
+// the Error field is set if the API returned a status code >= 400
+// which makes the response compatible with validate.Response
+type Response[T any] struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	Result       *T
+	Error        error
+}
+
+// StatusCode returns the HTTP status code
+func (r Response[T]) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+func do[T any](ctx context.Context, c *Client, method, path string, body interface{}) (*Response[T], error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rsp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Response[T]{
+		Body:         b,
+		HTTPResponse: rsp,
+	}
+
+	if rsp.StatusCode >= http.StatusBadRequest {
+		rsp.Body = io.NopCloser(bytes.NewReader(b))
+		res.Error = validate.DefaultResponseErrorHandler(rsp)
+		return res, nil
+	}
+
+	if len(b) > 0 && strings.Contains(rsp.Header.Get("Content-Type"), "json") {
+		var dest T
+		if err := json.Unmarshal(b, &dest); err != nil {
+			return nil, fmt.Errorf("failed decoding response: %w\nbody was: %s", err, string(b))
+		}
+		res.Result = &dest
+	}
+	return res, nil
+}
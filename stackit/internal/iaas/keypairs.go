@@ -0,0 +1,50 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Keypair is the key pair response
+type Keypair struct {
+	Name        string             `json:"name"`
+	PublicKey   string             `json:"publicKey"`
+	Fingerprint string             `json:"fingerprint,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+	CreatedAt   string             `json:"createdAt,omitempty"`
+}
+
+// CreateKeypairRequest is the key pair create request
+type CreateKeypairRequest struct {
+	Name      string             `json:"name"`
+	PublicKey string             `json:"publicKey"`
+	Labels    *map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateKeypairRequest is the key pair update request
+type UpdateKeypairRequest struct {
+	Labels *map[string]string `json:"labels,omitempty"`
+}
+
+// CreateKeypair imports a new public key
+// key pairs are bound to the user and not to a project
+func (c *Client) CreateKeypair(ctx context.Context, body CreateKeypairRequest) (*Response[Keypair], error) {
+	return do[Keypair](ctx, c, http.MethodPost, "/v1/keypairs", body)
+}
+
+// GetKeypair returns a key pair
+func (c *Client) GetKeypair(ctx context.Context, name string) (*Response[Keypair], error) {
+	return do[Keypair](ctx, c, http.MethodGet, fmt.Sprintf("/v1/keypairs/%s", url.PathEscape(name)), nil)
+}
+
+// UpdateKeypair updates the labels of a key pair
+func (c *Client) UpdateKeypair(ctx context.Context, name string, body UpdateKeypairRequest) (*Response[Keypair], error) {
+	return do[Keypair](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/keypairs/%s", url.PathEscape(name)), body)
+}
+
+// DeleteKeypair deletes a key pair
+func (c *Client) DeleteKeypair(ctx context.Context, name string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/keypairs/%s", url.PathEscape(name)), nil)
+}
@@ -0,0 +1,63 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// NetworkInterface is the network interface response
+type NetworkInterface struct {
+	ID               string             `json:"id"`
+	NetworkID        string             `json:"networkId"`
+	Name             *string            `json:"name,omitempty"`
+	IPv4             *string            `json:"ipv4,omitempty"`
+	IPv6             *string            `json:"ipv6,omitempty"`
+	Mac              *string            `json:"mac,omitempty"`
+	AllowedAddresses *[]string          `json:"allowedAddresses,omitempty"`
+	SecurityGroups   *[]string          `json:"securityGroups,omitempty"`
+	NicSecurity      *bool              `json:"nicSecurity,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+	Device           *string            `json:"device,omitempty"`
+	Status           string             `json:"status,omitempty"`
+}
+
+// CreateNetworkInterfaceRequest is the network interface create request
+type CreateNetworkInterfaceRequest struct {
+	Name             *string            `json:"name,omitempty"`
+	IPv4             *string            `json:"ipv4,omitempty"`
+	IPv6             *string            `json:"ipv6,omitempty"`
+	AllowedAddresses *[]string          `json:"allowedAddresses,omitempty"`
+	SecurityGroups   *[]string          `json:"securityGroups,omitempty"`
+	NicSecurity      *bool              `json:"nicSecurity,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateNetworkInterfaceRequest is the network interface update request
+type UpdateNetworkInterfaceRequest struct {
+	Name             *string            `json:"name,omitempty"`
+	AllowedAddresses *[]string          `json:"allowedAddresses,omitempty"`
+	SecurityGroups   *[]string          `json:"securityGroups,omitempty"`
+	NicSecurity      *bool              `json:"nicSecurity,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// CreateNetworkInterface creates a new network interface in the given network
+func (c *Client) CreateNetworkInterface(ctx context.Context, projectID, networkID string, body CreateNetworkInterfaceRequest) (*Response[NetworkInterface], error) {
+	return do[NetworkInterface](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/networks/%s/nics", projectID, networkID), body)
+}
+
+// GetNetworkInterface returns a network interface
+func (c *Client) GetNetworkInterface(ctx context.Context, projectID, networkID, nicID string) (*Response[NetworkInterface], error) {
+	return do[NetworkInterface](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/networks/%s/nics/%s", projectID, networkID, nicID), nil)
+}
+
+// UpdateNetworkInterface updates a network interface
+func (c *Client) UpdateNetworkInterface(ctx context.Context, projectID, networkID, nicID string, body UpdateNetworkInterfaceRequest) (*Response[NetworkInterface], error) {
+	return do[NetworkInterface](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/projects/%s/networks/%s/nics/%s", projectID, networkID, nicID), body)
+}
+
+// DeleteNetworkInterface deletes a network interface
+func (c *Client) DeleteNetworkInterface(ctx context.Context, projectID, networkID, nicID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/networks/%s/nics/%s", projectID, networkID, nicID), nil)
+}
@@ -0,0 +1,49 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PublicIP is the public IP response
+type PublicIP struct {
+	ID               string             `json:"id"`
+	IP               string             `json:"ip"`
+	NetworkInterface *string            `json:"networkInterface,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// CreatePublicIPRequest is the public IP create request
+type CreatePublicIPRequest struct {
+	IP               *string            `json:"ip,omitempty"`
+	NetworkInterface *string            `json:"networkInterface,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// UpdatePublicIPRequest is the public IP update request
+// a null network interface detaches the public IP
+type UpdatePublicIPRequest struct {
+	NetworkInterface *string            `json:"networkInterface"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// CreatePublicIP reserves a new public IP
+func (c *Client) CreatePublicIP(ctx context.Context, projectID string, body CreatePublicIPRequest) (*Response[PublicIP], error) {
+	return do[PublicIP](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/public-ips", projectID), body)
+}
+
+// GetPublicIP returns a public IP
+func (c *Client) GetPublicIP(ctx context.Context, projectID, publicIPID string) (*Response[PublicIP], error) {
+	return do[PublicIP](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/public-ips/%s", projectID, publicIPID), nil)
+}
+
+// UpdatePublicIP updates the network interface association and labels of a public IP
+func (c *Client) UpdatePublicIP(ctx context.Context, projectID, publicIPID string, body UpdatePublicIPRequest) (*Response[PublicIP], error) {
+	return do[PublicIP](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/projects/%s/public-ips/%s", projectID, publicIPID), body)
+}
+
+// DeletePublicIP releases a public IP
+func (c *Client) DeletePublicIP(ctx context.Context, projectID, publicIPID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/public-ips/%s", projectID, publicIPID), nil)
+}
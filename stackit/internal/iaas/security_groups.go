@@ -0,0 +1,93 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SecurityGroup is the security group response
+type SecurityGroup struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Description *string             `json:"description,omitempty"`
+	Labels      *map[string]string  `json:"labels,omitempty"`
+	Stateful    *bool               `json:"stateful,omitempty"`
+	Rules       []SecurityGroupRule `json:"rules,omitempty"`
+}
+
+// CreateSecurityGroupRequest is the security group create request
+type CreateSecurityGroupRequest struct {
+	Name        string             `json:"name"`
+	Description *string            `json:"description,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+	Stateful    *bool              `json:"stateful,omitempty"`
+}
+
+// UpdateSecurityGroupRequest is the security group update request
+type UpdateSecurityGroupRequest struct {
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+}
+
+// SecurityGroupRule is a rule of a security group
+// rules can't be updated
+type SecurityGroupRule struct {
+	ID                    string                 `json:"id,omitempty"`
+	SecurityGroupID       string                 `json:"securityGroupId,omitempty"`
+	Description           *string                `json:"description,omitempty"`
+	Direction             string                 `json:"direction"`
+	Ethertype             *string                `json:"ethertype,omitempty"`
+	Protocol              *SecurityGroupProtocol `json:"protocol,omitempty"`
+	PortRange             *PortRange             `json:"portRange,omitempty"`
+	IPRange               *string                `json:"ipRange,omitempty"`
+	RemoteSecurityGroupID *string                `json:"remoteSecurityGroupId,omitempty"`
+}
+
+// SecurityGroupProtocol is the protocol of a rule
+type SecurityGroupProtocol struct {
+	Name   *string `json:"name,omitempty"`
+	Number *int64  `json:"number,omitempty"`
+}
+
+// PortRange is a port range of a rule
+type PortRange struct {
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+}
+
+// CreateSecurityGroup creates a new security group
+func (c *Client) CreateSecurityGroup(ctx context.Context, projectID string, body CreateSecurityGroupRequest) (*Response[SecurityGroup], error) {
+	return do[SecurityGroup](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/security-groups", projectID), body)
+}
+
+// GetSecurityGroup returns a security group
+func (c *Client) GetSecurityGroup(ctx context.Context, projectID, securityGroupID string) (*Response[SecurityGroup], error) {
+	return do[SecurityGroup](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/security-groups/%s", projectID, securityGroupID), nil)
+}
+
+// UpdateSecurityGroup updates the name, description and labels of a security group
+func (c *Client) UpdateSecurityGroup(ctx context.Context, projectID, securityGroupID string, body UpdateSecurityGroupRequest) (*Response[SecurityGroup], error) {
+	return do[SecurityGroup](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/projects/%s/security-groups/%s", projectID, securityGroupID), body)
+}
+
+// DeleteSecurityGroup deletes a security group
+func (c *Client) DeleteSecurityGroup(ctx context.Context, projectID, securityGroupID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/security-groups/%s", projectID, securityGroupID), nil)
+}
+
+// CreateSecurityGroupRule creates a new rule in a security group
+func (c *Client) CreateSecurityGroupRule(ctx context.Context, projectID, securityGroupID string, body SecurityGroupRule) (*Response[SecurityGroupRule], error) {
+	return do[SecurityGroupRule](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/security-groups/%s/rules", projectID, securityGroupID), body)
+}
+
+// GetSecurityGroupRule returns a security group rule
+func (c *Client) GetSecurityGroupRule(ctx context.Context, projectID, securityGroupID, ruleID string) (*Response[SecurityGroupRule], error) {
+	return do[SecurityGroupRule](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/security-groups/%s/rules/%s", projectID, securityGroupID, ruleID), nil)
+}
+
+// DeleteSecurityGroupRule deletes a security group rule
+func (c *Client) DeleteSecurityGroupRule(ctx context.Context, projectID, securityGroupID, ruleID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/security-groups/%s/rules/%s", projectID, securityGroupID, ruleID), nil)
+}
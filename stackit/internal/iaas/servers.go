@@ -0,0 +1,170 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// Server states
+const (
+	ServerStatusActive = "ACTIVE"
+	ServerStatusError  = "ERROR"
+)
+
+// Server is the server response
+type Server struct {
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Status           string             `json:"status,omitempty"`
+	PowerStatus      string             `json:"powerStatus,omitempty"`
+	MachineType      string             `json:"machineType"`
+	AvailabilityZone string             `json:"availabilityZone,omitempty"`
+	ImageID          *string            `json:"imageId,omitempty"`
+	KeypairName      *string            `json:"keypairName,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+	BootVolume       *ServerBootVolume  `json:"bootVolume,omitempty"`
+	NICs             *[]ServerNIC       `json:"nics,omitempty"`
+	Volumes          *[]string          `json:"volumes,omitempty"`
+	CreatedAt        string             `json:"createdAt,omitempty"`
+	ErrorMessage     *string            `json:"errorMessage,omitempty"`
+}
+
+// ServerBootVolume is the boot volume of a server
+type ServerBootVolume struct {
+	ID                  *string       `json:"id,omitempty"`
+	Size                *int64        `json:"size,omitempty"`
+	PerformanceClass    *string       `json:"performanceClass,omitempty"`
+	DeleteOnTermination *bool         `json:"deleteOnTermination,omitempty"`
+	Source              *VolumeSource `json:"source,omitempty"`
+}
+
+// ServerNIC is a network interface attached to a server
+type ServerNIC struct {
+	NicID          string    `json:"nicId"`
+	NetworkID      string    `json:"networkId"`
+	IPv4           *string   `json:"ipv4,omitempty"`
+	IPv6           *string   `json:"ipv6,omitempty"`
+	Mac            *string   `json:"mac,omitempty"`
+	SecurityGroups *[]string `json:"securityGroups,omitempty"`
+}
+
+// CreateServerNetwork is the networking of a server
+// either NetworkID or NicIDs must be set
+type CreateServerNetwork struct {
+	NetworkID *string   `json:"networkId,omitempty"`
+	NicIDs    *[]string `json:"nicIds,omitempty"`
+}
+
+// CreateServerRequest is the server create request
+type CreateServerRequest struct {
+	Name             string               `json:"name"`
+	MachineType      string               `json:"machineType"`
+	AvailabilityZone *string              `json:"availabilityZone,omitempty"`
+	ImageID          *string              `json:"imageId,omitempty"`
+	BootVolume       *ServerBootVolume    `json:"bootVolume,omitempty"`
+	KeypairName      *string              `json:"keypairName,omitempty"`
+	Labels           *map[string]string   `json:"labels,omitempty"`
+	Networking       *CreateServerNetwork `json:"networking,omitempty"`
+	UserData         *string              `json:"userData,omitempty"`
+}
+
+// UpdateServerRequest is the server update request
+type UpdateServerRequest struct {
+	Name   *string            `json:"name,omitempty"`
+	Labels *map[string]string `json:"labels,omitempty"`
+}
+
+// ResizeServerRequest is the server resize request
+type ResizeServerRequest struct {
+	MachineType string `json:"machineType"`
+}
+
+// CreateServer creates a new server
+func (c *Client) CreateServer(ctx context.Context, projectID string, body CreateServerRequest) (*Response[Server], error) {
+	return do[Server](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/servers", projectID), body)
+}
+
+// GetServer returns a server
+func (c *Client) GetServer(ctx context.Context, projectID, serverID string) (*Response[Server], error) {
+	return do[Server](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/servers/%s?details=true", projectID, serverID), nil)
+}
+
+// UpdateServer updates the name and labels of a server
+func (c *Client) UpdateServer(ctx context.Context, projectID, serverID string, body UpdateServerRequest) (*Response[Server], error) {
+	return do[Server](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/projects/%s/servers/%s", projectID, serverID), body)
+}
+
+// ResizeServer changes the machine type of a server
+func (c *Client) ResizeServer(ctx context.Context, projectID, serverID string, body ResizeServerRequest) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/servers/%s/resize", projectID, serverID), body)
+}
+
+// DeleteServer deletes a server
+func (c *Client) DeleteServer(ctx context.Context, projectID, serverID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/servers/%s", projectID, serverID), nil)
+}
+
+// ServerWaitHandler waits for the server to reach the given status and returns it
+func (c *Client) ServerWaitHandler(ctx context.Context, projectID, serverID, status string) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.GetServer(ctx, projectID, serverID)
+		if err != nil {
+			return nil, false, err
+		}
+		if resp.Error != nil {
+			if resp.StatusCode() >= http.StatusInternalServerError {
+				// retry on server errors
+				return nil, false, nil
+			}
+			return nil, false, resp.Error
+		}
+		if resp.Result == nil {
+			return nil, false, nil
+		}
+
+		s := *resp.Result
+		switch s.Status {
+		case status:
+			return s, true, nil
+		case ServerStatusError:
+			msg := "server is in status ERROR"
+			if s.ErrorMessage != nil {
+				msg = fmt.Sprintf("%s: %s", msg, *s.ErrorMessage)
+			}
+			return nil, false, errors.New(msg)
+		}
+		return nil, false, nil
+	})
+}
+
+// ServerDeleteWaitHandler waits for the server to be deleted
+func (c *Client) ServerDeleteWaitHandler(ctx context.Context, projectID, serverID string) *wait.Handler {
+	return deleteWaitHandler(func() (int, error) {
+		resp, err := c.GetServer(ctx, projectID, serverID)
+		if err != nil {
+			return 0, err
+		}
+		return resp.StatusCode(), nil
+	})
+}
+
+// deleteWaitHandler waits until the given function returns 404
+func deleteWaitHandler(get func() (int, error)) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		code, err := get()
+		if err != nil {
+			return nil, false, err
+		}
+		switch {
+		case code == http.StatusNotFound:
+			return nil, true, nil
+		case code == http.StatusBadRequest, code == http.StatusUnauthorized, code == http.StatusForbidden:
+			return nil, false, fmt.Errorf("received status code %d while waiting for deletion", code)
+		}
+		return nil, false, nil
+	})
+}
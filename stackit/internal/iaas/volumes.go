@@ -0,0 +1,187 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+)
+
+// Volume states
+const (
+	VolumeStatusAvailable = "AVAILABLE"
+	VolumeStatusAttached  = "ATTACHED"
+	VolumeStatusError     = "ERROR"
+)
+
+// Volume is the volume response
+type Volume struct {
+	ID               string             `json:"id"`
+	Name             *string            `json:"name,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	AvailabilityZone string             `json:"availabilityZone"`
+	Size             *int64             `json:"size,omitempty"`
+	PerformanceClass *string            `json:"performanceClass,omitempty"`
+	Source           *VolumeSource      `json:"source,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+	ServerID         *string            `json:"serverId,omitempty"`
+	Status           string             `json:"status,omitempty"`
+	CreatedAt        string             `json:"createdAt,omitempty"`
+}
+
+// VolumeSource is the source a volume is created from
+type VolumeSource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// CreateVolumeRequest is the volume create request
+type CreateVolumeRequest struct {
+	Name             *string            `json:"name,omitempty"`
+	Description      *string            `json:"description,omitempty"`
+	AvailabilityZone string             `json:"availabilityZone"`
+	Size             *int64             `json:"size,omitempty"`
+	PerformanceClass *string            `json:"performanceClass,omitempty"`
+	Source           *VolumeSource      `json:"source,omitempty"`
+	Labels           *map[string]string `json:"labels,omitempty"`
+}
+
+// UpdateVolumeRequest is the volume update request
+type UpdateVolumeRequest struct {
+	Name        *string            `json:"name,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	Labels      *map[string]string `json:"labels,omitempty"`
+}
+
+// ResizeVolumeRequest is the volume resize request
+type ResizeVolumeRequest struct {
+	Size int64 `json:"size"`
+}
+
+// VolumeAttachment is the attachment of a volume to a server
+type VolumeAttachment struct {
+	ServerID            *string `json:"serverId,omitempty"`
+	VolumeID            *string `json:"volumeId,omitempty"`
+	DeleteOnTermination *bool   `json:"deleteOnTermination,omitempty"`
+}
+
+// CreateVolume creates a new volume
+func (c *Client) CreateVolume(ctx context.Context, projectID string, body CreateVolumeRequest) (*Response[Volume], error) {
+	return do[Volume](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/volumes", projectID), body)
+}
+
+// GetVolume returns a volume
+func (c *Client) GetVolume(ctx context.Context, projectID, volumeID string) (*Response[Volume], error) {
+	return do[Volume](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/volumes/%s", projectID, volumeID), nil)
+}
+
+// UpdateVolume updates the name, description and labels of a volume
+func (c *Client) UpdateVolume(ctx context.Context, projectID, volumeID string, body UpdateVolumeRequest) (*Response[Volume], error) {
+	return do[Volume](ctx, c, http.MethodPatch, fmt.Sprintf("/v1/projects/%s/volumes/%s", projectID, volumeID), body)
+}
+
+// ResizeVolume increases the size of a volume
+func (c *Client) ResizeVolume(ctx context.Context, projectID, volumeID string, body ResizeVolumeRequest) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodPost, fmt.Sprintf("/v1/projects/%s/volumes/%s/resize", projectID, volumeID), body)
+}
+
+// DeleteVolume deletes a volume
+func (c *Client) DeleteVolume(ctx context.Context, projectID, volumeID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/volumes/%s", projectID, volumeID), nil)
+}
+
+// AttachVolume attaches a volume to a server
+func (c *Client) AttachVolume(ctx context.Context, projectID, serverID, volumeID string, body VolumeAttachment) (*Response[VolumeAttachment], error) {
+	return do[VolumeAttachment](ctx, c, http.MethodPut, fmt.Sprintf("/v1/projects/%s/servers/%s/volume-attachments/%s", projectID, serverID, volumeID), body)
+}
+
+// GetVolumeAttachment returns the attachment of a volume to a server
+func (c *Client) GetVolumeAttachment(ctx context.Context, projectID, serverID, volumeID string) (*Response[VolumeAttachment], error) {
+	return do[VolumeAttachment](ctx, c, http.MethodGet, fmt.Sprintf("/v1/projects/%s/servers/%s/volume-attachments/%s", projectID, serverID, volumeID), nil)
+}
+
+// DetachVolume detaches a volume from a server
+func (c *Client) DetachVolume(ctx context.Context, projectID, serverID, volumeID string) (*Response[NoContent], error) {
+	return do[NoContent](ctx, c, http.MethodDelete, fmt.Sprintf("/v1/projects/%s/servers/%s/volume-attachments/%s", projectID, serverID, volumeID), nil)
+}
+
+// VolumeWaitHandler waits for the volume to reach one of the given states and returns it
+func (c *Client) VolumeWaitHandler(ctx context.Context, projectID, volumeID string, status ...string) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.GetVolume(ctx, projectID, volumeID)
+		if err != nil {
+			return nil, false, err
+		}
+		if resp.Error != nil {
+			if resp.StatusCode() >= http.StatusInternalServerError {
+				return nil, false, nil
+			}
+			return nil, false, resp.Error
+		}
+		if resp.Result == nil {
+			return nil, false, nil
+		}
+
+		v := *resp.Result
+		if v.Status == VolumeStatusError {
+			return nil, false, errors.New("volume is in status ERROR")
+		}
+		for _, s := range status {
+			if v.Status == s {
+				return v, true, nil
+			}
+		}
+		return nil, false, nil
+	})
+}
+
+// VolumeDeleteWaitHandler waits for the volume to be deleted
+func (c *Client) VolumeDeleteWaitHandler(ctx context.Context, projectID, volumeID string) *wait.Handler {
+	return deleteWaitHandler(func() (int, error) {
+		resp, err := c.GetVolume(ctx, projectID, volumeID)
+		if err != nil {
+			return 0, err
+		}
+		return resp.StatusCode(), nil
+	})
+}
+
+// VolumeAttachmentWaitHandler waits for the volume to be attached to the server
+func (c *Client) VolumeAttachmentWaitHandler(ctx context.Context, projectID, serverID, volumeID string) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.GetVolume(ctx, projectID, volumeID)
+		if err != nil {
+			return nil, false, err
+		}
+		if resp.Error != nil {
+			if resp.StatusCode() >= http.StatusInternalServerError {
+				return nil, false, nil
+			}
+			return nil, false, resp.Error
+		}
+		if resp.Result == nil {
+			return nil, false, nil
+		}
+		v := *resp.Result
+		if v.Status == VolumeStatusError {
+			return nil, false, errors.New("volume is in status ERROR")
+		}
+		if v.Status == VolumeStatusAttached && v.ServerID != nil && *v.ServerID == serverID {
+			return v, true, nil
+		}
+		return nil, false, nil
+	})
+}
+
+// VolumeDetachmentWaitHandler waits for the volume to be detached from the server
+func (c *Client) VolumeDetachmentWaitHandler(ctx context.Context, projectID, serverID, volumeID string) *wait.Handler {
+	return deleteWaitHandler(func() (int, error) {
+		resp, err := c.GetVolumeAttachment(ctx, projectID, serverID, volumeID)
+		if err != nil {
+			return 0, err
+		}
+		return resp.StatusCode(), nil
+	})
+}
@@ -7,8 +7,12 @@ import (
 	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/argus/v1.0/plans"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -19,12 +23,46 @@ const (
 	DefaultMetricsRetentionDays1hDownsampling int64 = 0
 )
 
-func (r Resource) loadPlanID(ctx context.Context, diags *diag.Diagnostics, s *Instance) {
-	c := r.client.Argus
+// ModifyPlan resolves the plan ID so an invalid plan fails during plan instead of during apply
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var projectID, plan types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("plan"), &plan)...)
+	if resp.Diagnostics.HasError() || projectID.IsUnknown() || plan.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var current types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("plan"), &current)...)
+		if resp.Diagnostics.HasError() || plan.Equal(current) {
+			return
+		}
+	}
 
-	res, err := c.Plans.ListPlans(ctx, s.ProjectID.ValueString())
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		diags.AddError("failed to list argus plans", agg.Error())
+	s := Instance{ProjectID: projectID, Plan: plan, PlanID: types.StringNull()}
+	r.loadPlanID(ctx, &resp.Diagnostics, &s)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("plan_id"), s.PlanID)...)
+}
+
+func (r Resource) loadPlanID(ctx context.Context, diags *diag.Diagnostics, s *Instance) {
+	projectID := s.ProjectID.ValueString()
+	res, err := cache.Get(cache.For(r.client), cache.ArgusPlans, projectID, func() (*plans.ListPlansResponse, error) {
+		res, err := r.client.Argus.Plans.ListPlans(ctx, projectID)
+		if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		diags.AddError("failed to list argus plans", err.Error())
 		return
 	}
 
@@ -12,7 +12,6 @@ import (
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Create - lifecycle function
@@ -43,11 +42,10 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		resp.Diagnostics.AddError("failed to process client response", "Credentials response is empty")
 	}
 
-	if err := r.applyClientResponse(ctx, &cred, res.JSON200); err != nil {
+	if err := r.applyClientResponse(ctx, &cred, res.JSON200, res.Body); err != nil {
 		resp.Diagnostics.AddError("failed to process client response", err.Error())
 		return
 	}
-	cred.RawResponse = types.StringValue(string(res.Body))
 
 	// update state
 	diags = resp.State.Set(ctx, &cred)
@@ -80,11 +78,10 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		resp.Diagnostics.AddError("failed to process client response", "Credentials response is empty")
 	}
 
-	if err := r.applyClientResponse(ctx, &cred, res.JSON200); err != nil {
+	if err := r.applyClientResponse(ctx, &cred, res.JSON200, res.Body); err != nil {
 		resp.Diagnostics.AddError("failed to process client response", err.Error())
 		return
 	}
-	cred.RawResponse = types.StringValue(string(res.Body))
 
 	// update state
 	diags = resp.State.Set(ctx, &cred)
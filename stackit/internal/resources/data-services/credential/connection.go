@@ -0,0 +1,154 @@
+package credential
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/pkg/errors"
+)
+
+// protocol is a single entry of the `protocols` object in the credentials response
+type protocol struct {
+	URI      string   `json:"uri"`
+	Hosts    []string `json:"hosts"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+}
+
+// credentialsBody contains the parts of the credentials response
+// which aren't covered by the generated client types
+type credentialsBody struct {
+	Raw struct {
+		Credentials struct {
+			Host      string              `json:"host"`
+			Port      *int                `json:"port"`
+			Name      *string             `json:"name"`
+			Username  string              `json:"username"`
+			Password  string              `json:"password"`
+			Protocols map[string]protocol `json:"protocols"`
+		} `json:"credentials"`
+	} `json:"raw"`
+}
+
+// connectionAttributes returns the service specific connection attributes
+func (s ResourceService) connectionAttributes() map[string]schema.Attribute {
+	uri := func(description string) schema.Attribute {
+		return schema.StringAttribute{
+			Description: description,
+			Computed:    true,
+			Sensitive:   true,
+		}
+	}
+	switch s {
+	case Redis:
+		return map[string]schema.Attribute{
+			"tls_uri": uri("TLS enabled connection URI (`rediss://`), null if the instance doesn't offer TLS connections"),
+			"sentinel_hosts": schema.ListAttribute{
+				Description: "the sentinel hosts of replicated plans",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		}
+	case RabbitMQ:
+		return map[string]schema.Attribute{
+			"amqp_uri":       uri("AMQP connection URI"),
+			"management_uri": uri("management API and UI URI"),
+		}
+	case Opensearch, LogMe, ElasticSearch:
+		return map[string]schema.Attribute{
+			"dashboard_uri": schema.StringAttribute{
+				Description: "dashboard URI",
+				Computed:    true,
+			},
+			"dashboard_username": schema.StringAttribute{
+				Description: "dashboard username",
+				Computed:    true,
+			},
+			"dashboard_password": schema.StringAttribute{
+				Description: "dashboard password",
+				Computed:    true,
+				Sensitive:   true,
+			},
+		}
+	case Postgres, MariaDB:
+		return map[string]schema.Attribute{
+			"jdbc_uri": uri("JDBC connection URI"),
+		}
+	}
+	return map[string]schema.Attribute{}
+}
+
+// connectionTypes returns the attribute types of the `connection` object
+func (s ResourceService) connectionTypes() map[string]attr.Type {
+	t := map[string]attr.Type{}
+	for k, v := range s.connectionAttributes() {
+		t[k] = v.GetType()
+	}
+	return t
+}
+
+// connectionFromBody builds the `connection` object from the raw credentials response
+func (s ResourceService) connectionFromBody(body []byte) (types.Object, error) {
+	t := s.connectionTypes()
+	var b credentialsBody
+	if err := json.Unmarshal(body, &b); err != nil {
+		return types.ObjectNull(t), errors.Wrap(err, "failed to parse credentials response")
+	}
+
+	c := b.Raw.Credentials
+	str := func(v string) types.String {
+		if v == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(v)
+	}
+
+	values := map[string]attr.Value{}
+	switch s {
+	case Redis:
+		values["tls_uri"] = str(c.Protocols["rediss"].URI)
+		values["sentinel_hosts"] = types.ListNull(types.StringType)
+		if hosts := c.Protocols["sentinel"].Hosts; len(hosts) > 0 {
+			elems := []attr.Value{}
+			for _, h := range hosts {
+				elems = append(elems, types.StringValue(h))
+			}
+			values["sentinel_hosts"] = types.ListValueMust(types.StringType, elems)
+		}
+	case RabbitMQ:
+		values["amqp_uri"] = str(c.Protocols["amqp"].URI)
+		values["management_uri"] = str(c.Protocols["management"].URI)
+	case Opensearch, LogMe, ElasticSearch:
+		dashboard := c.Protocols["dashboard"]
+		values["dashboard_uri"] = str(dashboard.URI)
+		values["dashboard_username"] = str(dashboard.Username)
+		values["dashboard_password"] = str(dashboard.Password)
+	case Postgres, MariaDB:
+		values["jdbc_uri"] = str(jdbcURI(s, c.Host, c.Port, c.Name, c.Username, c.Password))
+	}
+
+	obj, diags := types.ObjectValue(t, values)
+	if diags.HasError() {
+		return types.ObjectNull(t), errors.New("failed setting connection object")
+	}
+	return obj, nil
+}
+
+// jdbcURI builds the JDBC URI of a database credential
+func jdbcURI(s ResourceService, host string, port *int, name *string, username, password string) string {
+	if host == "" || port == nil || name == nil {
+		return ""
+	}
+	driver := "postgresql"
+	if s == MariaDB {
+		driver = "mariadb"
+	}
+	q := url.Values{}
+	q.Set("user", username)
+	q.Set("password", password)
+	return fmt.Sprintf("jdbc:%s://%s:%d/%s?%s", driver, host, *port, *name, q.Encode())
+}
@@ -0,0 +1,78 @@
+package credential
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConnectionFromBody(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		service ResourceService
+		body    string
+		want    map[string]string
+		null    []string
+	}{
+		{
+			name:    "rabbitmq",
+			service: RabbitMQ,
+			body:    `{"raw":{"credentials":{"protocols":{"amqp":{"uri":"amqp://u:p@host:5672"},"management":{"uri":"https://u:p@host:15672"}}}}}`,
+			want:    map[string]string{"amqp_uri": "amqp://u:p@host:5672", "management_uri": "https://u:p@host:15672"},
+		},
+		{
+			name:    "redis tls uri",
+			service: Redis,
+			body:    `{"uri":"redis://u:p@host:6379","raw":{"credentials":{"protocols":{"rediss":{"uri":"rediss://u:p@host:6380"}}}}}`,
+			want:    map[string]string{"tls_uri": "rediss://u:p@host:6380"},
+		},
+		{
+			name:    "redis without tls",
+			service: Redis,
+			body:    `{"uri":"redis://u:p@host:6379","raw":{"credentials":{}}}`,
+			null:    []string{"tls_uri", "sentinel_hosts"},
+		},
+		{
+			name:    "opensearch dashboard",
+			service: Opensearch,
+			body:    `{"raw":{"credentials":{"protocols":{"dashboard":{"uri":"https://dashboard","username":"u","password":"p"}}}}}`,
+			want:    map[string]string{"dashboard_uri": "https://dashboard", "dashboard_username": "u", "dashboard_password": "p"},
+		},
+		{
+			name:    "postgres jdbc",
+			service: Postgres,
+			body:    `{"raw":{"credentials":{"host":"host","port":5432,"name":"db","username":"u","password":"p"}}}`,
+			want:    map[string]string{"jdbc_uri": "jdbc:postgresql://host:5432/db?password=p&user=u"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj, err := tt.service.connectionFromBody([]byte(tt.body))
+			if err != nil {
+				t.Fatalf("connectionFromBody() error = %v", err)
+			}
+			attrs := obj.Attributes()
+			for k, want := range tt.want {
+				if got := attrs[k].(types.String).ValueString(); got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+			for _, k := range tt.null {
+				if !attrs[k].IsNull() {
+					t.Errorf("%s = %v, want null", k, attrs[k])
+				}
+			}
+		})
+	}
+
+	if _, err := Redis.connectionFromBody([]byte("not json")); err == nil {
+		t.Error("expected error for invalid body")
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func (r Resource) applyClientResponse(ctx context.Context, c *Credential, cgr *credentials.CredentialsResponse) error {
+func (r Resource) applyClientResponse(ctx context.Context, c *Credential, cgr *credentials.CredentialsResponse, body []byte) error {
 	c.ID = types.StringValue(cgr.ID)
 	c.Host = types.StringValue(cgr.Raw.Credentials.Host)
 
@@ -33,5 +33,11 @@ func (r Resource) applyClientResponse(ctx context.Context, c *Credential, cgr *c
 	c.SyslogDrainURL = types.StringValue(cgr.Raw.SyslogDrainUrl)
 	c.RouteServiceURL = types.StringValue(cgr.Raw.RouteServiceUrl)
 	c.URI = types.StringValue(cgr.Uri)
+
+	conn, err := r.service.connectionFromBody(body)
+	if err != nil {
+		return err
+	}
+	c.Connection = conn
 	return nil
 }
@@ -106,6 +106,7 @@ type Resource struct {
 }
 
 var _ = resource.Resource(&Resource{})
+var _ = resource.ResourceWithUpgradeState(&Resource{})
 
 // Metadata returns data resource metadata
 func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
@@ -28,12 +28,13 @@ type Credential struct {
 	SyslogDrainURL  types.String `tfsdk:"syslog_drain_url"`
 	RouteServiceURL types.String `tfsdk:"route_service_url"`
 	URI             types.String `tfsdk:"uri"`
-	RawResponse     types.String `tfsdk:"raw_response"`
+	Connection      types.Object `tfsdk:"connection"`
 }
 
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages %s credentials\n%s",
 			r.service.Display(),
 			common.EnvironmentInfo(r.urls),
@@ -114,10 +115,10 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 			},
 
-			"raw_response": schema.StringAttribute{
-				Description: "The full API response (as JSON string)",
+			"connection": schema.SingleNestedAttribute{
+				Description: fmt.Sprintf("%s specific connection details", r.service.Display()),
 				Computed:    true,
-				Sensitive:   true,
+				Attributes:  r.service.connectionAttributes(),
 			},
 		},
 	}
@@ -0,0 +1,30 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// UpgradeState upgrades the state of previous schema versions
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	res := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, res)
+
+	return map[int64]resource.StateUpgrader{
+		// version 0 had `raw_response` instead of `connection`, which is set on the next read
+		0: {
+			PriorSchema: common.PriorSchema(res.Schema, map[string]schema.Attribute{
+				"raw_response": schema.StringAttribute{
+					Computed:  true,
+					Sensitive: true,
+				},
+			}, "connection"),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				common.UpgradeState(ctx, req, resp, nil)
+			},
+		},
+	}
+}
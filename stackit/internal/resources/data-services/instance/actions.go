@@ -32,26 +32,13 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	acl := []string{}
-	for _, v := range plan.ACL.Elements() {
-		nv, err := common.ToString(ctx, v)
-		if err != nil {
-			continue
-		}
-		acl = append(acl, nv)
-	}
-
 	// handle creation
-	aclString := strings.Join(acl, ",")
-	params := instances.InstanceParameters{
-		SgwAcl: &aclString,
-	}
-	body := instances.InstanceProvisionRequest{
-		InstanceName: plan.Name.ValueString(),
-		PlanID:       plan.PlanID.ValueString(),
-		Parameters:   &params,
+	body, err := r.requestBody(ctx, &plan, map[string]interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to prepare provisioning request", err.Error())
+		return
 	}
-	res, err := r.client.Instances.Provision(ctx, plan.ProjectID.ValueString(), body)
+	res, err := r.client.Instances.ProvisionWithBody(ctx, plan.ProjectID.ValueString(), "application/json", body)
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON202"); agg != nil {
 		resp.Diagnostics.AddError("failed instance provisioning", agg.Error())
 		return
@@ -92,8 +79,8 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	if err := r.applyClientResponse(ctx, &plan, i.JSON200); err != nil {
-		resp.Diagnostics.AddError("failed to process client response", err.Error())
+	resp.Diagnostics.Append(r.applyClientResponse(ctx, &plan, i.JSON200)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -123,8 +110,8 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	if err := r.applyClientResponse(ctx, &state, res.JSON200); err != nil {
-		resp.Diagnostics.AddError("failed to process client response", err.Error())
+	resp.Diagnostics.Append(r.applyClientResponse(ctx, &state, res.JSON200)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -156,25 +143,24 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
-	acl := []string{}
-	for _, v := range plan.ACL.Elements() {
-		nv, err := common.ToString(ctx, v)
-		if err != nil {
-			continue
-		}
-		acl = append(acl, nv)
+	// parameters unknown to the provider are sent back unchanged
+	current, err := r.client.Instances.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, current, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed to get instance", agg.Error())
+		return
+	}
+	params := map[string]interface{}{}
+	for k, v := range current.JSON200.Parameters {
+		params[k] = v
 	}
 
 	// handle update
-	aclString := strings.Join(acl, ",")
-	params := instances.InstanceParameters{
-		SgwAcl: &aclString,
-	}
-	body := instances.UpdateJSONRequestBody{
-		PlanID:     plan.PlanID.ValueString(),
-		Parameters: &params,
+	body, err := r.requestBody(ctx, &plan, params)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to prepare update request", err.Error())
+		return
 	}
-	res, err := r.client.Instances.Update(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), body)
+	res, err := r.client.Instances.UpdateWithBody(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), "application/json", body)
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
 		resp.Diagnostics.AddError("failed instance update", agg.Error())
 		return
@@ -191,16 +177,20 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
-	// mitigate an API bug that returns old data after an update completed
-	time.Sleep(1 * time.Minute)
-
-	newRes, err := r.client.Instances.Get(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
-	if agg := common.Validate(&resp.Diagnostics, newRes, err, "JSON200"); agg != nil {
-		resp.Diagnostics.AddError("failed to read after update", agg.Error())
+	// the API may return old data after an update completed
+	verify := r.planWaitHandler(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), plan.PlanID.ValueString()).SetTimeout(timeout)
+	result, err := verify.WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to verify the plan change", err.Error())
 		return
 	}
-	if err := r.applyClientResponse(ctx, &plan, newRes.JSON200); err != nil {
-		resp.Diagnostics.AddError("failed to process client response", err.Error())
+	newRes, ok := result.(*instances.GetResponse)
+	if !ok {
+		resp.Diagnostics.AddError("failed to parse client response", "response is not of *instances.GetResponse")
+		return
+	}
+	resp.Diagnostics.Append(r.applyClientResponse(ctx, &plan, newRes.JSON200)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 	// update state
@@ -1,12 +1,16 @@
 package instance
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -14,44 +18,49 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (r Resource) getDefaultVersion() string {
-	switch r.service {
-	case ElasticSearch:
-		return "7"
-	case LogMe:
-		return "2"
-	case MariaDB:
-		return "10.6"
-	case Opensearch:
-		return "2"
-	case Postgres:
-		return "13"
-	case Redis:
-		return "6"
-	case RabbitMQ:
-		return "3.10"
-	}
-	return ""
+// defaultVersion returns the version of the offering marked as latest
+func defaultVersion(offers []offerings.Offering) (string, error) {
+	for _, offer := range offers {
+		if offer.Latest {
+			return offer.Version, nil
+		}
+	}
+	return "", errors.New("no offered version is marked as latest, please set `version`")
 }
 
-func (r Resource) getDefaultPlan() string {
-	switch r.service {
-	case ElasticSearch:
-		return "stackit-elasticsearch-1.4.10-single"
-	case LogMe:
-		return "stackit-logme2-1.4.10-single"
-	case MariaDB:
-		return "stackit-mariadb-1.4.10-single"
-	case Opensearch:
-		return "stackit-opensearch-1.4.10-single"
-	case Postgres:
-		return "stackit-postgresql-1.4.10-single"
-	case Redis:
-		return "stackit-redis-1.4.10-single"
-	case RabbitMQ:
-		return "stackit-rabbitmq-2.4.10-single"
-	}
-	return ""
+// defaultPlan returns the first single node plan of the version, or its first plan
+func defaultPlan(offers []offerings.Offering, version string) (string, error) {
+	for _, offer := range offers {
+		if offer.Version != version || len(offer.Plans) == 0 {
+			continue
+		}
+		for _, p := range offer.Plans {
+			if strings.Contains(p.Name, "single") {
+				return p.Name, nil
+			}
+		}
+		return offer.Plans[0].Name, nil
+	}
+	return "", fmt.Errorf("no plans are offered for version '%s', please set `plan`", version)
+}
+
+// setDefaults sets the version and plan from the offerings if they aren't set
+func setDefaults(offers []offerings.Offering, data *Instance) error {
+	if data.Version.IsUnknown() || data.Version.IsNull() {
+		v, err := defaultVersion(offers)
+		if err != nil {
+			return err
+		}
+		data.Version = types.StringValue(v)
+	}
+	if data.Plan.IsUnknown() || data.Plan.IsNull() {
+		p, err := defaultPlan(offers, data.Version.ValueString())
+		if err != nil {
+			return err
+		}
+		data.Plan = types.StringValue(p)
+	}
+	return nil
 }
 
 func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data *Instance) error {
@@ -59,16 +68,20 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data *I
 		return errors.New("at least 1 ip address must be specified for `acl`")
 	}
 
-	res, err := r.client.Offerings.List(ctx, data.ProjectID.ValueString())
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		return agg
+	offers, err := r.loadOfferings(ctx, diags, data.ProjectID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	if err := setDefaults(offers, data); err != nil {
+		return err
 	}
 
-	if err := r.validateVersion(ctx, res.JSON200.Offerings, data.Version.ValueString()); err != nil {
+	if err := r.validateVersion(ctx, offers, data.Version.ValueString()); err != nil {
 		return err
 	}
 
-	planID, err := r.validatePlan(ctx, res.JSON200.Offerings, data.Version.ValueString(), data.Plan.ValueString())
+	planID, err := r.validatePlan(ctx, offers, data.Version.ValueString(), data.Plan.ValueString())
 	if err != nil {
 		return err
 	}
@@ -76,6 +89,11 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data *I
 	return nil
 }
 
+// loadOfferings returns the offerings of the project, which are cached for all instances
+func (r Resource) loadOfferings(ctx context.Context, diags *diag.Diagnostics, projectID string) ([]offerings.Offering, error) {
+	return cache.DataServicesOfferingsOf(ctx, r.cache, diags, r.client, string(r.service), projectID)
+}
+
 func (r Resource) validateVersion(ctx context.Context, offers []offerings.Offering, version string) error {
 	opts := []string{}
 	for _, offer := range offers {
@@ -105,19 +123,29 @@ func (r Resource) validatePlan(ctx context.Context, offers []offerings.Offering,
 	return "", fmt.Errorf("couldn't find plan name '%s' for version '%s'. Available options are:\n%s\n", planName, version, strings.Join(opts, "\n"))
 }
 
-func (r Resource) applyClientResponse(ctx context.Context, pi *Instance, i *instances.Instance) error {
+func (r Resource) applyClientResponse(ctx context.Context, pi *Instance, i *instances.Instance) diag.Diagnostics {
+	var diags diag.Diagnostics
 	elems := []attr.Value{}
-	if acl, ok := i.Parameters["sgw_acl"]; ok {
-		aclString, ok := acl.(string)
+	if acl, ok := i.Parameters[aclParameter]; ok {
+		items, ok := stringList(acl)
 		if !ok {
-			return errors.New("couldn't parse acl interface as string")
+			diags.AddError("failed to process client response", "couldn't parse acl interface as string")
+			return diags
 		}
-		items := strings.Split(aclString, ",")
 		for _, v := range items {
 			elems = append(elems, types.StringValue(v))
 		}
 	}
 	pi.ACL = types.ListValueMust(types.StringType, elems)
+	params, d := r.service.parametersFromAPI(i.Parameters)
+	if diags.Append(d...); diags.HasError() {
+		return diags
+	}
+	pi.Parameters = params
+	pi.BackupSchedule = types.StringNull()
+	if v, ok := i.Parameters[backupScheduleParameter]; ok && v != nil {
+		pi.BackupSchedule = types.StringValue(fmt.Sprint(v))
+	}
 	pi.Name = types.StringValue(i.Name)
 	pi.PlanID = types.StringValue(i.PlanID)
 	pi.DashboardURL = types.StringValue(i.DashboardUrl)
@@ -127,7 +155,41 @@ func (r Resource) applyClientResponse(ctx context.Context, pi *Instance, i *inst
 	if i.OrganizationGUID != nil {
 		pi.CFOrganizationGUID = types.StringValue(*i.OrganizationGUID)
 	}
-	return nil
+	return diags
+}
+
+// requestBody encodes a provision or update request
+// the generated request types only cover a subset of the instance parameters
+func (r Resource) requestBody(ctx context.Context, data *Instance, params map[string]interface{}) (io.Reader, error) {
+	acl := []string{}
+	for _, v := range data.ACL.Elements() {
+		nv, err := common.ToString(ctx, v)
+		if err != nil {
+			continue
+		}
+		acl = append(acl, nv)
+	}
+	params[aclParameter] = strings.Join(acl, ",")
+	if !data.BackupSchedule.IsNull() && !data.BackupSchedule.IsUnknown() {
+		params[backupScheduleParameter] = data.BackupSchedule.ValueString()
+	}
+
+	if err := parametersToAPI(ctx, data.Parameters, params); err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"planId":     data.PlanID.ValueString(),
+		"parameters": params,
+	}
+	if data.ID.IsNull() || data.ID.IsUnknown() {
+		body["instanceName"] = data.Name.ValueString()
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
 }
 
 func (r Resource) getPlanAndVersion(ctx context.Context, diags *diag.Diagnostics, projectID, instanceID string) (plan, version string, err error) {
@@ -136,12 +198,12 @@ func (r Resource) getPlanAndVersion(ctx context.Context, diags *diag.Diagnostics
 		return "", "", agg
 	}
 
-	res, err := r.client.Offerings.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
-		return "", "", agg
+	offers, err := r.loadOfferings(ctx, diags, projectID)
+	if err != nil {
+		return "", "", err
 	}
 
-	for _, offer := range res.JSON200.Offerings {
+	for _, offer := range offers {
 		for _, p := range offer.Plans {
 			if p.ID != i.JSON200.PlanID {
 				continue
@@ -0,0 +1,397 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// aclParameter is managed by the `acl` attribute
+	aclParameter = "sgw_acl"
+
+	// backupScheduleParameter is managed by the `backup_schedule` attribute
+	backupScheduleParameter = "backup_schedule"
+)
+
+var cronField = regexp.MustCompile(`^[0-9*/,-]+$`)
+
+// redisMaxMemoryPolicies are the eviction policies supported by Redis
+var redisMaxMemoryPolicies = []string{
+	"volatile-lru",
+	"allkeys-lru",
+	"volatile-lfu",
+	"allkeys-lfu",
+	"volatile-random",
+	"allkeys-random",
+	"volatile-ttl",
+	"noeviction",
+}
+
+// parameterNames returns the instance parameters supported by the service
+func (s ResourceService) parameterNames() []string {
+	names := []string{
+		"enable_monitoring",
+		"graphite",
+		"max_disk_threshold",
+		"metrics_frequency",
+		"metrics_prefix",
+		"monitoring_instance_id",
+		"syslog",
+	}
+	switch s {
+	case ElasticSearch:
+		names = append(names, "plugins")
+	case Postgres:
+		names = append(names, "extensions")
+	case LogMe:
+		names = append(names, "java_heapspace", "java_maxmetaspace")
+	case Opensearch:
+		names = append(names, "java_heapspace", "java_maxmetaspace", "plugins", "tls_ciphers")
+	case Redis:
+		names = append(names, "maxmemory_policy", "tls_ciphers")
+	case RabbitMQ:
+		names = append(names, "plugins", "tls_ciphers")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parameterAttribute returns the schema of a single instance parameter
+// all parameters are computed so values set outside of terraform are kept
+func parameterAttribute(name string) schema.Attribute {
+	switch name {
+	case "enable_monitoring":
+		return schema.BoolAttribute{
+			Description: "enables monitoring of the instance",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "graphite":
+		return schema.StringAttribute{
+			Description: "graphite server to push metrics to, e.g. `graphite.example.com:2003`",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "max_disk_threshold":
+		return schema.Int64Attribute{
+			Description: "disk usage threshold in percent at which the instance is protected from further writes",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.Between(50, 100),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	case "metrics_frequency":
+		return schema.Int64Attribute{
+			Description: "frequency in seconds in which metrics are pushed",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(10),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	case "metrics_prefix":
+		return schema.StringAttribute{
+			Description: "prefix of the pushed metrics",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "monitoring_instance_id":
+		return schema.StringAttribute{
+			Description: "ID of the Argus instance (`stackit_argus_instance`) the metrics are pushed to",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				validate.UUID(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "syslog":
+		return schema.ListAttribute{
+			Description: "syslog targets to forward logs to, e.g. `logs.example.com:514`",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "extensions":
+		return schema.ListAttribute{
+			Description: "PostgreSQL extensions to install, e.g. `pg_stat_statements`",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "plugins":
+		return schema.ListAttribute{
+			Description: "plugins to enable",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "tls_ciphers":
+		return schema.ListAttribute{
+			Description: "allowed TLS ciphers",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		}
+	case "java_heapspace":
+		return schema.Int64Attribute{
+			Description: "java heap space in MB",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(256),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	case "java_maxmetaspace":
+		return schema.Int64Attribute{
+			Description: "java max metaspace in MB",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(256),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		}
+	case "maxmemory_policy":
+		return schema.StringAttribute{
+			Description: "eviction policy when the memory limit is reached",
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(redisMaxMemoryPolicies...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	return nil
+}
+
+// parametersSchema returns the `parameters` attribute for the service
+func (s ResourceService) parametersSchema() schema.SingleNestedAttribute {
+	attrs := map[string]schema.Attribute{}
+	for _, name := range s.parameterNames() {
+		attrs[name] = parameterAttribute(name)
+	}
+	description := fmt.Sprintf("%s specific instance parameters. Parameters that aren't set keep their current value.", s.Display())
+	if s == MariaDB {
+		description = "MariaDB instance parameters. Only the parameters common to all data services are supported. Parameters that aren't set keep their current value."
+	}
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Attributes:  attrs,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// parameterTypes returns the attribute types of the `parameters` object
+func (s ResourceService) parameterTypes() map[string]attr.Type {
+	t := map[string]attr.Type{}
+	for _, name := range s.parameterNames() {
+		t[name] = parameterAttribute(name).GetType()
+	}
+	return t
+}
+
+// parametersToAPI writes the known values of the `parameters` object into the API parameters
+func parametersToAPI(ctx context.Context, obj types.Object, params map[string]interface{}) error {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	for name, v := range obj.Attributes() {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		switch tv := v.(type) {
+		case types.String:
+			params[name] = tv.ValueString()
+		case types.Int64:
+			params[name] = tv.ValueInt64()
+		case types.Bool:
+			params[name] = tv.ValueBool()
+		case types.List:
+			items := []string{}
+			if d := tv.ElementsAs(ctx, &items, false); d.HasError() {
+				return fmt.Errorf("failed to convert parameter %s", name)
+			}
+			params[name] = items
+		default:
+			return fmt.Errorf("unsupported type of parameter %s", name)
+		}
+	}
+	return nil
+}
+
+// parametersFromAPI builds the `parameters` object of the service from the API parameters
+// parameters with unexpected values are set to null with a warning, so the instance can still be read
+func (s ResourceService) parametersFromAPI(params map[string]interface{}) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	t := s.parameterTypes()
+	values := map[string]attr.Value{}
+	for name, at := range t {
+		v, ok := params[name]
+		if !ok || v == nil {
+			values[name] = nullValue(at)
+			continue
+		}
+		av, err := toValue(at, v)
+		if err != nil {
+			diags.AddWarning(fmt.Sprintf("failed to parse parameter %s", name), fmt.Sprintf("the parameter is set to null: %s", err.Error()))
+			av = nullValue(at)
+		}
+		values[name] = av
+	}
+	obj, d := types.ObjectValue(t, values)
+	if diags.Append(d...); diags.HasError() {
+		return types.ObjectNull(t), diags
+	}
+	return obj, diags
+}
+
+func nullValue(t attr.Type) attr.Value {
+	switch t {
+	case types.StringType:
+		return types.StringNull()
+	case types.Int64Type:
+		return types.Int64Null()
+	case types.BoolType:
+		return types.BoolNull()
+	}
+	return types.ListNull(types.StringType)
+}
+
+// toValue converts a decoded JSON value, numbers and booleans may be returned as strings
+func toValue(t attr.Type, v interface{}) (attr.Value, error) {
+	switch t {
+	case types.StringType:
+		return types.StringValue(fmt.Sprint(v)), nil
+	case types.Int64Type:
+		switch n := v.(type) {
+		case float64:
+			return types.Int64Value(int64(n)), nil
+		case string:
+			i, err := strconv.ParseInt(n, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			return types.Int64Value(i), nil
+		}
+	case types.BoolType:
+		switch b := v.(type) {
+		case bool:
+			return types.BoolValue(b), nil
+		case string:
+			p, err := strconv.ParseBool(b)
+			if err != nil {
+				return nil, err
+			}
+			return types.BoolValue(p), nil
+		}
+	default:
+		items, ok := stringList(v)
+		if !ok {
+			break
+		}
+		elems := []attr.Value{}
+		for _, item := range items {
+			elems = append(elems, types.StringValue(item))
+		}
+		return types.ListValueMust(types.StringType, elems), nil
+	}
+	return nil, fmt.Errorf("unexpected value %v", v)
+}
+
+// stringList converts a list parameter, which may be returned as comma separated string like `sgw_acl`
+func stringList(v interface{}) ([]string, bool) {
+	switch l := v.(type) {
+	case string:
+		if l == "" {
+			return []string{}, true
+		}
+		return strings.Split(l, ","), true
+	case []interface{}:
+		items := []string{}
+		for _, item := range l {
+			items = append(items, fmt.Sprint(item))
+		}
+		return items, true
+	}
+	return nil, false
+}
+
+// validateCron validates a cron expression with 5 fields
+func validateCron(s string) error {
+	fields := strings.Fields(s)
+	if len(fields) != 5 {
+		return fmt.Errorf("expected 5 fields (minute hour day month weekday), got %d", len(fields))
+	}
+	for _, f := range fields {
+		if !cronField.MatchString(f) {
+			return fmt.Errorf("invalid field %q", f)
+		}
+	}
+	return nil
+}
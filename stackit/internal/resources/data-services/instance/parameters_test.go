@@ -0,0 +1,130 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParameters(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	raw := `{
+		"sgw_acl": "0.0.0.0/0",
+		"maxmemory_policy": "allkeys-lru",
+		"metrics_frequency": 30,
+		"enable_monitoring": "true",
+		"tls_ciphers": ["TLS_AES_256_GCM_SHA384"],
+		"syslog": "logs.example.com:514,logs2.example.com:514",
+		"down_after_milliseconds": 10000
+	}`
+	params := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(raw), &params); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, diags := Redis.parametersFromAPI(params)
+	if diags.HasError() || diags.WarningsCount() > 0 {
+		t.Fatalf("parametersFromAPI() diagnostics = %v", diags)
+	}
+	attrs := obj.Attributes()
+	if len(attrs) != len(Redis.parameterNames()) {
+		t.Errorf("got %d attributes, want %d", len(attrs), len(Redis.parameterNames()))
+	}
+	if v := attrs["maxmemory_policy"].(types.String); v.ValueString() != "allkeys-lru" {
+		t.Errorf("maxmemory_policy = %v", v)
+	}
+	if v := attrs["metrics_frequency"].(types.Int64); v.ValueInt64() != 30 {
+		t.Errorf("metrics_frequency = %v", v)
+	}
+	if v := attrs["enable_monitoring"].(types.Bool); !v.ValueBool() {
+		t.Errorf("enable_monitoring = %v", v)
+	}
+	if v := attrs["syslog"].(types.List); len(v.Elements()) != 2 {
+		t.Errorf("syslog = %v, want 2 targets from comma separated string", v)
+	}
+	if _, ok := attrs["plugins"]; ok {
+		t.Error("plugins isn't a Redis parameter")
+	}
+
+	// unknown parameters are kept, known values are overwritten
+	out := map[string]interface{}{"down_after_milliseconds": float64(10000)}
+	if err := parametersToAPI(ctx, obj, out); err != nil {
+		t.Fatalf("parametersToAPI() error = %v", err)
+	}
+	want := map[string]interface{}{
+		"down_after_milliseconds": float64(10000),
+		"maxmemory_policy":        "allkeys-lru",
+		"metrics_frequency":       int64(30),
+		"enable_monitoring":       true,
+		"tls_ciphers":             []string{"TLS_AES_256_GCM_SHA384"},
+		"syslog":                  []string{"logs.example.com:514", "logs2.example.com:514"},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("parametersToAPI() = %v, want %v", out, want)
+	}
+
+	// invalid values are set to null with a warning
+	obj, diags = Redis.parametersFromAPI(map[string]interface{}{"metrics_frequency": "often", "maxmemory_policy": "noeviction"})
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a single warning for invalid metrics_frequency, got %v", diags)
+	}
+	attrs = obj.Attributes()
+	if v := attrs["metrics_frequency"]; !v.IsNull() {
+		t.Errorf("metrics_frequency = %v, want null", v)
+	}
+	if v := attrs["maxmemory_policy"].(types.String); v.ValueString() != "noeviction" {
+		t.Errorf("maxmemory_policy = %v", v)
+	}
+}
+
+func TestParameterNames(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	for _, s := range []ResourceService{ElasticSearch, LogMe, MariaDB, Opensearch, Postgres, Redis, RabbitMQ} {
+		for _, name := range s.parameterNames() {
+			if parameterAttribute(name) == nil {
+				t.Errorf("%s: missing schema for parameter %s", s, name)
+			}
+		}
+	}
+}
+
+func Test_validateCron(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		cron    string
+		wantErr bool
+	}{
+		{"daily", "0 2 * * *", false},
+		{"steps and lists", "*/15 1,13 * * 1-5", false},
+		{"too few fields", "0 2 * *", true},
+		{"invalid field", "0 2 * * mon", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCron(tt.cron); (err != nil) != tt.wantErr {
+				t.Errorf("validateCron() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	dataservices "github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -101,11 +102,13 @@ func NewOpensearch() resource.Resource {
 // Resource is the exported resource
 type Resource struct {
 	client  *dataservices.ClientWithResponses
+	cache   *cache.Cache
 	service ResourceService
 	urls    baseurl.BaseURL
 }
 
 var _ = resource.Resource(&Resource{})
+var _ = resource.ResourceWithModifyPlan(&Resource{})
 
 // Metadata returns data resource metadata
 func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
@@ -134,6 +137,7 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 }
 
 func (r *Resource) setClient(c *services.Services) {
+	r.cache = cache.For(c)
 	switch r.service {
 	case ElasticSearch:
 		r.client = c.ElasticSearch
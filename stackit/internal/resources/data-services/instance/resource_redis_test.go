@@ -43,6 +43,8 @@ func TestAcc_ResourceRedisInstanceJob(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_redis_instance.example", "version", version),
 					resource.TestCheckResourceAttr("stackit_redis_instance.example", "plan", plan1),
 					resource.TestCheckResourceAttr("stackit_redis_instance.example", "plan_id", planID1),
+					resource.TestCheckResourceAttr("stackit_redis_instance.example", "parameters.maxmemory_policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr("stackit_redis_instance.example", "parameters.metrics_frequency", "30"),
 					resource.TestCheckResourceAttrSet("stackit_redis_instance.example", "id"),
 					resource.TestCheckResourceAttrSet("stackit_redis_instance.example", "dashboard_url"),
 					resource.TestCheckResourceAttrSet("stackit_redis_instance.example", "cf_guid"),
@@ -93,6 +95,10 @@ func configInstRedis(name, plan, version string) string {
 		project_id = "%s"
 		version    = "%s"
 		plan       = "%s"
+		parameters = {
+			maxmemory_policy = "allkeys-lru"
+			metrics_frequency = 30
+		}
 	  }
 	  
 	  `,
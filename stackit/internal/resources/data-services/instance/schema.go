@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -30,6 +29,8 @@ type Instance struct {
 	CFGUID             types.String   `tfsdk:"cf_guid"`
 	CFSpaceGUID        types.String   `tfsdk:"cf_space_guid"`
 	CFOrganizationGUID types.String   `tfsdk:"cf_organization_guid"`
+	Parameters         types.Object   `tfsdk:"parameters"`
+	BackupSchedule     types.String   `tfsdk:"backup_schedule"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
@@ -68,20 +69,24 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 			"plan": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("The %s Plan. Default is `%s`", r.service.Display(), r.getDefaultPlan()),
+				MarkdownDescription: fmt.Sprintf("The %s Plan. Defaults to the first single node plan of `version`. Available plans are listed by the `stackit_%s_offerings` data source. Changing the plan updates the instance in place.", r.service.Display(), r.service),
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(r.getDefaultPlan()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"plan_id": schema.StringAttribute{
 				Description: "The selected plan ID",
 				Computed:    true,
 			},
 			"version": schema.StringAttribute{
-				MarkdownDescription: fmt.Sprintf("%s version. Default is %s", r.service.Display(), r.getDefaultVersion()),
+				MarkdownDescription: fmt.Sprintf("%s version. Defaults to the latest offered version. Available versions are listed by the `stackit_%s_offerings` data source. The instance is upgraded in place to the next offered version, downgrades and skipping versions aren't supported.", r.service.Display(), r.service),
 				Optional:            true,
 				Computed:            true,
-				Default:             stringdefault.StaticString(r.getDefaultVersion()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl": schema.ListAttribute{
 				Description: "Access Control rules to whitelist IP addresses",
@@ -108,6 +113,18 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Description: "Cloud Foundry Organization GUID",
 				Computed:    true,
 			},
+			"parameters": r.service.parametersSchema(),
+			"backup_schedule": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("cron expression (UTC) of the automatic backups, e.g. `0 2 * * *`. Backups are listed by the `stackit_%s_backups` data source and restored with `stackit_%s_restore`.", r.service, r.service),
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validate.StringWith(validateCron, "validate backup_schedule is a cron expression"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
@@ -0,0 +1,159 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Masterminds/semver"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/instances"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModifyPlan sets the default version and plan from the offerings and checks them
+// so unsupported values fail during plan instead of during apply
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to check on destroy or before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, config Instance
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	// version and plan are only unknown without configured value on creation
+	if (plan.Version.IsUnknown() && config.Version.IsNull()) || (plan.Plan.IsUnknown() && config.Plan.IsNull()) {
+		offers, err := r.loadOfferings(ctx, &resp.Diagnostics, plan.ProjectID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list offerings", err.Error())
+			return
+		}
+		if config.Version.IsNull() && plan.Version.IsUnknown() {
+			v, err := defaultVersion(offers)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("version"), "no default version", err.Error())
+				return
+			}
+			plan.Version = types.StringValue(v)
+		}
+		if config.Plan.IsNull() && plan.Plan.IsUnknown() && !plan.Version.IsUnknown() {
+			p, err := defaultPlan(offers, plan.Version.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("plan"), "no default plan", err.Error())
+				return
+			}
+			plan.Plan = types.StringValue(p)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), plan.Version)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("plan"), plan.Plan)...)
+	}
+	if plan.Version.IsUnknown() || plan.Plan.IsUnknown() {
+		return
+	}
+
+	var state Instance
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Version.Equal(state.Version) && plan.Plan.Equal(state.Plan) {
+			return
+		}
+	}
+
+	offers, err := r.loadOfferings(ctx, &resp.Diagnostics, plan.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list offerings", err.Error())
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		err = r.validateVersion(ctx, offers, plan.Version.ValueString())
+	} else {
+		err = checkUpgrade(offers, state.Version.ValueString(), plan.Version.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("version"), "unsupported version", err.Error())
+		return
+	}
+
+	planID, err := r.validatePlan(ctx, offers, plan.Version.ValueString(), plan.Plan.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("plan"), "unsupported plan", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("plan_id"), types.StringValue(planID))...)
+}
+
+// checkUpgrade verifies a version can be upgraded in place
+// downgrades and skipping an offered version aren't supported by the API
+func checkUpgrade(offers []offerings.Offering, from, to string) error {
+	if from == to {
+		return nil
+	}
+
+	fromVersion, err := semver.NewVersion(from)
+	if err != nil {
+		return fmt.Errorf("couldn't parse current version %s: %w", from, err)
+	}
+	toVersion, err := semver.NewVersion(to)
+	if err != nil {
+		return fmt.Errorf("couldn't parse version %s: %w", to, err)
+	}
+	if toVersion.LessThan(fromVersion) {
+		return fmt.Errorf("downgrading from version %s to %s isn't supported. The instance needs to be recreated", from, to)
+	}
+
+	found := false
+	for _, offer := range offers {
+		if offer.Version == to {
+			found = true
+			continue
+		}
+		v, err := semver.NewVersion(offer.Version)
+		if err != nil {
+			continue
+		}
+		if v.GreaterThan(fromVersion) && v.LessThan(toVersion) {
+			return fmt.Errorf("upgrading from version %s to %s skips version %s. Upgrade to %s first", from, to, offer.Version, offer.Version)
+		}
+	}
+	if !found {
+		return fmt.Errorf("version %s isn't offered", to)
+	}
+	return nil
+}
+
+// planWaitHandler waits until the instance reports the given plan ID
+// the API may report a finished update before the plan change took effect
+func (r Resource) planWaitHandler(ctx context.Context, projectID, instanceID, planID string) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		s, err := r.client.Instances.Get(ctx, projectID, instanceID)
+		if err = validate.Response(s, err, "JSON200"); err != nil {
+			if validate.StatusEquals(s, http.StatusBadGateway, http.StatusGatewayTimeout, http.StatusInternalServerError) {
+				return nil, false, nil
+			}
+			return nil, false, err
+		}
+		if s.JSON200.PlanID != planID {
+			return nil, false, nil
+		}
+		if s.JSON200.LastOperation.State != instances.SUCCEEDED {
+			return nil, false, nil
+		}
+		return s, true, nil
+	})
+}
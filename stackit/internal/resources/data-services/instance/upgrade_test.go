@@ -0,0 +1,90 @@
+package instance
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/data-services/v1.0/offerings"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_checkUpgrade(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	offers := []offerings.Offering{
+		{Version: "11"},
+		{Version: "13"},
+		{Version: "14"},
+		{Version: "3.10"},
+	}
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{"same version", "13", "13", false},
+		{"next version", "11", "13", false},
+		{"skipped version", "11", "14", true},
+		{"downgrade", "14", "13", true},
+		{"not offered", "14", "15", true},
+		{"minor version", "3.9", "3.10", false},
+		{"invalid version", "13", "latest", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkUpgrade(offers, tt.from, tt.to); (err != nil) != tt.wantErr {
+				t.Errorf("checkUpgrade() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_setDefaults(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	offers := []offerings.Offering{
+		{Version: "6", Plans: []offerings.Plan{{Name: "stackit-redis-1.2.10-replica"}, {Name: "stackit-redis-1.2.10-single"}}},
+		{Version: "7", Latest: true, Plans: []offerings.Plan{{Name: "stackit-redis-1.4.10-replica"}, {Name: "stackit-redis-1.4.10-single"}}},
+		{Version: "8", Plans: []offerings.Plan{{Name: "stackit-redis-1.4.10-replica"}}},
+	}
+	tests := []struct {
+		name        string
+		version     types.String
+		plan        types.String
+		offers      []offerings.Offering
+		wantVersion string
+		wantPlan    string
+		wantErr     bool
+	}{
+		{"latest version", types.StringUnknown(), types.StringUnknown(), offers, "7", "stackit-redis-1.4.10-single", false},
+		{"configured version", types.StringValue("6"), types.StringNull(), offers, "6", "stackit-redis-1.2.10-single", false},
+		{"no single plan", types.StringValue("8"), types.StringUnknown(), offers, "8", "stackit-redis-1.4.10-replica", false},
+		{"configured plan", types.StringUnknown(), types.StringValue("custom"), offers, "7", "custom", false},
+		{"no latest version", types.StringUnknown(), types.StringUnknown(), offers[:1], "", "", true},
+		{"version not offered", types.StringValue("5"), types.StringUnknown(), offers, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := Instance{Version: tt.version, Plan: tt.plan}
+			err := setDefaults(tt.offers, &data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setDefaults() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if data.Version.ValueString() != tt.wantVersion || data.Plan.ValueString() != tt.wantPlan {
+				t.Errorf("setDefaults() = %s/%s, want %s/%s", data.Version.ValueString(), data.Plan.ValueString(), tt.wantVersion, tt.wantPlan)
+			}
+		})
+	}
+}
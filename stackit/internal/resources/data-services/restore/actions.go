@@ -0,0 +1,113 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/dsa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Restore
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, instanceID := plan.ProjectID.ValueString(), plan.InstanceID.ValueString()
+	res, err := r.client.TriggerRestore(ctx, projectID, instanceID, plan.BackupID.ValueInt64())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed to trigger restore", agg.Error())
+		return
+	}
+
+	// store the ID so a failed restore isn't triggered again
+	restoreID := res.Result.ID
+	plan.ID = types.StringValue(strconv.FormatInt(restoreID, 10))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 60*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	process := r.client.RestoreWaitHandler(ctx, projectID, instanceID, restoreID).SetTimeout(timeout)
+	result, err := process.WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to verify restore %d", restoreID), err.Error())
+		return
+	}
+
+	restore, ok := result.(dsa.Restore)
+	if !ok {
+		resp.Diagnostics.AddError("failed to parse client response", "response is not of dsa.Restore")
+		return
+	}
+	applyRestore(&plan, restore)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Restore
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	restoreID, err := strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to parse restore ID", err.Error())
+		return
+	}
+
+	restore, err := r.client.GetRestore(ctx, state.ProjectID.ValueString(), state.InstanceID.ValueString(), restoreID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to list restores", err.Error())
+		return
+	}
+
+	// the restore history isn't kept forever, the resource only records the action
+	if restore == nil {
+		return
+	}
+
+	applyRestore(&state, *restore)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+// all arguments require replacement, only the timeouts can be updated
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Restore
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+// a restore can't be undone, the resource is only removed from the state
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func applyRestore(state *Restore, restore dsa.Restore) {
+	state.Status = types.StringValue(restore.Status)
+	state.FinishedAt = types.StringValue(restore.FinishedAt)
+	state.TriggeredAt = types.StringNull()
+	if restore.TriggeredAt != nil {
+		state.TriggeredAt = types.StringValue(*restore.TriggeredAt)
+	}
+}
@@ -0,0 +1,94 @@
+package restore_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ResourceMariaDBRestore(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(7, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// create an instance that is backed up every minute
+			{
+				Config: instanceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mariadb_instance.example", "backup_schedule", "* * * * *"),
+					resource.TestCheckResourceAttrSet("stackit_mariadb_instance.example", "id"),
+				),
+			},
+			// restore the latest backup once the schedule has run
+			{
+				PreConfig: func() { time.Sleep(5 * time.Minute) },
+				Config:    config(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mariadb_restore.example", "status", "finished"),
+					resource.TestCheckResourceAttrPair("stackit_mariadb_restore.example", "instance_id", "stackit_mariadb_instance.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_mariadb_restore.example", "backup_id", "data.stackit_mariadb_backups.example", "latest_backup_id"),
+					resource.TestCheckResourceAttrSet("stackit_mariadb_restore.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_mariadb_restore.example", "finished_at"),
+				),
+			},
+			// a changed trigger restores again
+			{
+				Config: config(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_mariadb_restore.example", "status", "finished"),
+					resource.TestCheckResourceAttr("stackit_mariadb_restore.example", "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func instanceConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_mariadb_instance" "example" {
+		name            = "%s"
+		project_id      = "%s"
+		backup_schedule = "* * * * *"
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
+
+func config(name, run string) string {
+	return instanceConfig(name) + fmt.Sprintf(`
+	data "stackit_mariadb_backups" "example" {
+		project_id  = stackit_mariadb_instance.example.project_id
+		instance_id = stackit_mariadb_instance.example.id
+	}
+
+	resource "stackit_mariadb_restore" "example" {
+		project_id  = data.stackit_mariadb_backups.example.project_id
+		instance_id = data.stackit_mariadb_backups.example.instance_id
+		backup_id   = data.stackit_mariadb_backups.example.latest_backup_id
+		triggers = {
+			run = "%s"
+		}
+	}
+	`,
+		run,
+	)
+}
@@ -0,0 +1,111 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Restore is the schema model
+type Restore struct {
+	ID          types.String      `tfsdk:"id"`
+	ProjectID   types.String      `tfsdk:"project_id"`
+	InstanceID  types.String      `tfsdk:"instance_id"`
+	BackupID    types.Int64       `tfsdk:"backup_id"`
+	Triggers    map[string]string `tfsdk:"triggers"`
+	Status      types.String      `tfsdk:"status"`
+	TriggeredAt types.String      `tfsdk:"triggered_at"`
+	FinishedAt  types.String      `tfsdk:"finished_at"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Restores a %s instance from a backup\n\n"+
+			"The restore is triggered when the resource is created and the apply waits until it finished. "+
+			"Changing any argument triggers a new restore. Deleting the resource has no effect on the instance.\n%s",
+			r.service.Display(),
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the restore ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The instance ID.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backup_id": schema.Int64Attribute{
+				Description: fmt.Sprintf("the ID of the backup to restore, see `stackit_%s_backups`", r.service),
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "arbitrary values that trigger a new restore when changed",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "the restore status",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggered_at": schema.StringAttribute{
+				Description: "the time the restore was triggered",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"finished_at": schema.StringAttribute{
+				Description: "the time the restore finished",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
@@ -0,0 +1,139 @@
+package keypair
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Keypair
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := iaas.CreateKeypairRequest{
+		Name:      plan.Name.ValueString(),
+		PublicKey: strings.TrimSpace(plan.PublicKey.ValueString()),
+	}
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+
+	res, err := r.iaas.CreateKeypair(ctx, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating key pair %s", body.Name), agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toState(k iaas.Keypair, state *Keypair) {
+	state.ID = types.StringValue(k.Name)
+	state.Name = types.StringValue(k.Name)
+	state.Fingerprint = types.StringValue(k.Fingerprint)
+
+	// keep the configured format of the key, the API trims it
+	if state.PublicKey.IsNull() || strings.TrimSpace(state.PublicKey.ValueString()) != k.PublicKey {
+		state.PublicKey = types.StringValue(k.PublicKey)
+	}
+
+	state.Labels = nil
+	if k.Labels != nil && len(*k.Labels) > 0 {
+		state.Labels = *k.Labels
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Keypair
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetKeypair(ctx, state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading key pair", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Keypair
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := plan.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	res, err := r.iaas.UpdateKeypair(ctx, state.ID.ValueString(), iaas.UpdateKeypairRequest{
+		Labels: &labels,
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed updating key pair", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Keypair
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.DeleteKeypair(ctx, state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting key pair", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: `name`",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID)...)
+}
@@ -0,0 +1,75 @@
+package keypair_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+const publicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEzqMiAbNwZbyxR6ABbkTnPXWpjo0OUDnTbUTTXBIu4c"
+
+func TestAcc_Keypair(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_keypair.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_keypair.example", "id", name),
+					resource.TestCheckResourceAttr("stackit_keypair.example", "public_key", publicKey),
+					resource.TestCheckResourceAttr("stackit_keypair.example", "labels.key", "a"),
+					resource.TestCheckResourceAttrSet("stackit_keypair.example", "fingerprint"),
+				),
+			},
+			// update labels
+			{
+				Config: config(name, "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_keypair.example", "labels.key", "b"),
+				),
+			},
+			// test import
+			{
+				ResourceName:      "stackit_keypair.example",
+				ImportStateId:     name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, label string) string {
+	return fmt.Sprintf(`
+	resource "stackit_keypair" "example" {
+		name       = "%s"
+		public_key = "%s"
+		labels = {
+			"key" = "%s"
+		}
+	}
+	`,
+		name,
+		publicKey,
+		label,
+	)
+}
@@ -0,0 +1,71 @@
+package keypair
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Keypair is the schema model
+type Keypair struct {
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	PublicKey   types.String      `tfsdk:"public_key"`
+	Fingerprint types.String      `tfsdk:"fingerprint"`
+	Labels      map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT key pair used for SSH access to servers. Key pairs are bound to the user and not to a project.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the key pair ID, equal to its name",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "the key pair name. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "the public SSH key, for example `ssh-ed25519 AAAA...`. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "the fingerprint of the public key",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "labels of the key pair",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
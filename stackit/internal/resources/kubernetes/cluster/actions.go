@@ -2,6 +2,7 @@ package cluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/credentials"
 	serviceenablement "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-enablement/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -33,12 +35,6 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &plan)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// handle project init
 	r.enableProject(ctx, &resp.Diagnostics, &plan, timeout)
 	if resp.Diagnostics.HasError() {
@@ -66,27 +62,23 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 }
 
-func (r Resource) preProcessConfig(diags *diag.Diagnostics, cl *Cluster) {
+func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, cl *Cluster, timeout time.Duration) {
 	projectID := cl.ProjectID.ValueString()
-	kubernetesProjectID := cl.KubernetesProjectID.ValueString()
-	if projectID == "" && kubernetesProjectID == "" {
-		diags.AddError("project_id or kubernetes_project_id must be set", "please note that kubernetes_project_id is deprecated and will be removed in a future release")
-		return
-	}
-	if projectID == "" {
-		cl.ProjectID = cl.KubernetesProjectID
-	}
-	if kubernetesProjectID == "" {
-		cl.KubernetesProjectID = cl.ProjectID
-	}
+	serviceID := "cloud.stackit.ske"
+
+	// successful enablements are cached, so clusters of the same project only check once
+	_, _ = cache.Get(cache.For(r.client), cache.ServiceEnablement, serviceID+"/"+projectID, func() (bool, error) {
+		r.enableService(ctx, diags, projectID, serviceID, timeout)
+		if diags.HasError() {
+			return false, errors.New("SKE service enablement failed")
+		}
+		return true, nil
+	})
 }
 
-func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, cl *Cluster, timeout time.Duration) {
-	projectID := cl.ProjectID.ValueString()
+func (r Resource) enableService(ctx context.Context, diags *diag.Diagnostics, projectID, serviceID string, timeout time.Duration) {
 	c := r.client.ServiceEnablement
 
-	serviceID := "cloud.stackit.ske"
-
 	found := true
 	status, err := c.GetService(ctx, projectID, serviceID)
 	if agg := common.Validate(&diag.Diagnostics{}, status, err, "JSON200.State"); agg != nil {
@@ -216,12 +208,6 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	// pre process state
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// read cluster
 	res, err := c.Kubernetes.Cluster.Get(ctx, state.ProjectID.ValueString(), state.Name.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
@@ -258,12 +244,6 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
-	// pre process state
-	r.preProcessConfig(&resp.Diagnostics, &plan)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	timeout, d := plan.Timeouts.Update(ctx, 1*time.Hour)
 	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
 		return
@@ -297,12 +277,6 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	c := r.client
 	res, err := c.Kubernetes.Cluster.Delete(ctx, state.ProjectID.ValueString(), state.Name.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
@@ -333,7 +307,7 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: `kubernetes_project_id,name` where `name` is the cluster name.\nInstead got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: `project_id,name` where `name` is the cluster name.\nInstead got: %q", req.ID),
 		)
 		return
 	}
@@ -351,13 +325,12 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	if err := clientValidate.ProjectID(idParts[0]); err != nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Couldn't validate kubernetes_project_id.\n%s", err.Error()),
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
 		)
 		return
 	}
 
 	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kubernetes_project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
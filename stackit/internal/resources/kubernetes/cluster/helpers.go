@@ -6,8 +6,10 @@ import (
 
 	"github.com/Masterminds/semver"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
+	provideroptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/provider-options"
 	"github.com/pkg/errors"
 
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,12 +31,22 @@ const (
 	DefaultVersion                      = "1.26"
 )
 
+// loadProviderOptions returns the provider options, which are cached for all clusters
+func (r Resource) loadProviderOptions(ctx context.Context, diags *diag.Diagnostics) (*provideroptions.ListResponse, error) {
+	return cache.Get(cache.For(r.client), cache.KubernetesProviderOptions, "", func() (*provideroptions.ListResponse, error) {
+		res, err := r.client.Kubernetes.ProviderOptions.List(ctx)
+		if agg := common.Validate(diags, res, err, "JSON200.KubernetesVersions"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+}
+
 func (r Resource) loadAvaiableVersions(ctx context.Context, diags *diag.Diagnostics) ([]*semver.Version, error) {
-	c := r.client
 	var versionOptions []*semver.Version
-	res, err := c.Kubernetes.ProviderOptions.List(ctx)
-	if agg := common.Validate(diags, res, err, "JSON200.KubernetesVersions"); agg != nil {
-		return nil, errors.Wrap(agg, "failed fetching cluster versions")
+	res, err := r.loadProviderOptions(ctx, diags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed fetching cluster versions")
 	}
 
 	opts := res.JSON200
@@ -24,6 +24,8 @@ type Resource struct {
 }
 
 var _ = resource.Resource(&Resource{})
+var _ = resource.ResourceWithUpgradeState(&Resource{})
+var _ = resource.ResourceWithModifyPlan(&Resource{})
 
 // Metadata returns data resource metadata
 func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
@@ -26,7 +26,6 @@ import (
 type Cluster struct {
 	ID                        types.String   `tfsdk:"id"`
 	Name                      types.String   `tfsdk:"name"`
-	KubernetesProjectID       types.String   `tfsdk:"kubernetes_project_id"`
 	ProjectID                 types.String   `tfsdk:"project_id"`
 	KubernetesVersion         types.String   `tfsdk:"kubernetes_version"`
 	KubernetesVersionUsed     types.String   `tfsdk:"kubernetes_version_used"`
@@ -95,6 +94,7 @@ type ArgusExtension struct {
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages kubernetes clusters\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
@@ -113,16 +113,6 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			// TODO: remove in next releases
-			"kubernetes_project_id": schema.StringAttribute{
-				Description:        "The ID of a `stackit_kubernetes_project` resource",
-				DeprecationMessage: "This attribute is deprecated and will be removed in a future version. Please use the `project_id` attribute instead.",
-				Optional:           true,
-				Computed:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-			},
 			"project_id": schema.StringAttribute{
 				Description: "The project UUID.",
 				Required:    true,
@@ -0,0 +1,34 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeState upgrades the state of previous schema versions
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	res := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, res)
+
+	return map[int64]resource.StateUpgrader{
+		// version 0 had the deprecated `kubernetes_project_id`
+		0: {
+			PriorSchema: common.PriorSchema(res.Schema, map[string]schema.Attribute{
+				"kubernetes_project_id": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			}),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				common.UpgradeState(ctx, req, resp, func(attrs map[string]tftypes.Value) error {
+					common.MoveAttribute(attrs, "kubernetes_project_id", "project_id")
+					return nil
+				})
+			},
+		},
+	}
+}
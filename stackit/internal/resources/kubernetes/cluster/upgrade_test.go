@@ -0,0 +1,43 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+		want    string
+	}{
+		{"both set", `{"id":"cl","name":"cl","kubernetes_project_id":"old","project_id":"new"}`, "new"},
+		{"only deprecated set", `{"id":"cl","name":"cl","kubernetes_project_id":"old","project_id":null}`, "old"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			state, diags := common.ApplyStateUpgrade(ctx, &Resource{}, 0, tt.fixture)
+			if diags.HasError() {
+				t.Fatalf("ApplyStateUpgrade() diagnostics: %v", diags)
+			}
+			var got types.String
+			if diags := state.GetAttribute(ctx, path.Root("project_id"), &got); diags.HasError() {
+				t.Fatalf("failed to get project_id: %v", diags)
+			}
+			if got.ValueString() != tt.want {
+				t.Errorf("project_id = %q, want %q", got.ValueString(), tt.want)
+			}
+		})
+	}
+}
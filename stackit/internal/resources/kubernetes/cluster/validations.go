@@ -9,10 +9,160 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/cluster"
 	provideroptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/kubernetes/v1.1/provider-options"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
-	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// ModifyPlan validates the cluster against the provider options
+// so invalid values fail during plan instead of during apply
+// checks whose values aren't known yet are skipped
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, without changes or before the provider is configured
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	var projectID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("project_id"), &projectID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !projectID.IsUnknown() {
+		if err := validate.ProjectID(projectID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("project_id"), "invalid project ID", err.Error())
+			return
+		}
+	}
+
+	// the provider options aren't project specific
+	opts, err := r.loadProviderOptions(ctx, &resp.Diagnostics)
+	if err != nil {
+		// if options cannot be fetched, skip validation
+		return
+	}
+	r.validateKnownVersion(ctx, &resp.Diagnostics, req.Plan, opts)
+	r.validateKnownNodePools(ctx, &resp.Diagnostics, req.Plan, opts)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the general cluster validation needs the full configuration
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := r.loadAvaiableVersions(ctx, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("failed while loading version options", err.Error())
+		return
+	}
+	clusterConfig, err := plan.clusterConfig(versions)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("kubernetes_version"), "Failed to create cluster config", err.Error())
+		return
+	}
+	nodePools := setNodepoolDefaults(plan.nodePools())
+	extensions, d := plan.extensions(ctx)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.validate(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.Name.ValueString(), clusterConfig, &nodePools, plan.maintenance(), plan.hibernations(), extensions); err != nil {
+		resp.Diagnostics.AddError("Failed cluster validation", err.Error())
+	}
+}
+
+// validateKnownVersion validates the planned kubernetes version if it's known
+func (r Resource) validateKnownVersion(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, opts *provideroptions.ListResponse) {
+	c := Cluster{}
+	diags.Append(plan.GetAttribute(ctx, path.Root("kubernetes_version"), &c.KubernetesVersion)...)
+	if diags.HasError() || c.KubernetesVersion.IsUnknown() {
+		return
+	}
+
+	versions, err := r.loadAvaiableVersions(ctx, diags)
+	if err != nil {
+		diags.AddError("failed while loading version options", err.Error())
+		return
+	}
+	clusterConfig, err := c.clusterConfig(versions)
+	if err != nil {
+		diags.AddAttributeError(path.Root("kubernetes_version"), "Failed to create cluster config", err.Error())
+		return
+	}
+	if err := validateKubernetesVersion(clusterConfig.Version, *opts.JSON200.KubernetesVersions); err != nil {
+		diags.AddAttributeError(path.Root("kubernetes_version"), "invalid kubernetes version", err.Error())
+	}
+}
+
+// validateKnownNodePools validates the image, machine type, volume type and zones
+// of the planned node pools which are known
+func (r Resource) validateKnownNodePools(ctx context.Context, diags *diag.Diagnostics, plan tfsdk.Plan, opts *provideroptions.ListResponse) {
+	var pools types.List
+	diags.Append(plan.GetAttribute(ctx, path.Root("node_pools"), &pools)...)
+	if diags.HasError() || pools.IsUnknown() || pools.IsNull() {
+		return
+	}
+
+	for i := range pools.Elements() {
+		p := path.Root("node_pools").AtListIndex(i)
+		var np NodePool
+		for name, v := range map[string]*types.String{
+			"os_name":      &np.OSName,
+			"os_version":   &np.OSVersion,
+			"machine_type": &np.MachineType,
+			"volume_type":  &np.VolumeType,
+		} {
+			diags.Append(plan.GetAttribute(ctx, p.AtName(name), v)...)
+		}
+		diags.Append(plan.GetAttribute(ctx, p.AtName("zones"), &np.Zones)...)
+		if diags.HasError() {
+			return
+		}
+
+		if !np.OSName.IsUnknown() && !np.OSVersion.IsUnknown() {
+			name := np.OSName.ValueString()
+			if name == "" {
+				name = DefaultOSName
+			}
+			if _, err := validateMachineImage(name, np.OSVersion.ValueString(), opts.JSON200.MachineImages); err != nil {
+				diags.AddAttributeError(p.AtName("os_version"), "invalid machine image", err.Error())
+			}
+		}
+		if !np.MachineType.IsUnknown() {
+			if err := validateMachineType(np.MachineType.ValueString(), opts.JSON200.MachineTypes); err != nil {
+				diags.AddAttributeError(p.AtName("machine_type"), "invalid machine type", err.Error())
+			}
+		}
+		if !np.VolumeType.IsUnknown() {
+			volType := np.VolumeType.ValueString()
+			if volType == "" {
+				volType = DefaultVolumeType
+			}
+			if err := validateVolumeType(volType, opts.JSON200.VolumeTypes); err != nil {
+				diags.AddAttributeError(p.AtName("volume_type"), "invalid volume type", err.Error())
+			}
+		}
+		if zones, ok := knownStrings(np.Zones); ok {
+			if len(zones) == 0 {
+				zones = []string{DefaultZone}
+			}
+			if err := validateZones(zones, opts.JSON200.AvailabilityZones); err != nil {
+				diags.AddAttributeError(p.AtName("zones"), "invalid zones", err.Error())
+			}
+		}
+	}
+}
+
 func (r Resource) validate(
 	ctx context.Context,
 	diags *diag.Diagnostics,
@@ -31,10 +181,8 @@ func (r Resource) validate(
 	}
 
 	// Validate against real options
-	c := r.client
-	opts, err := c.Kubernetes.ProviderOptions.List(ctx)
-
-	if agg := common.Validate(diags, opts, err, "JSON200.KubernetesVersions"); agg != nil {
+	opts, err := r.loadProviderOptions(ctx, diags)
+	if err != nil {
 		// if options cannot be fetched, skip validation
 		return nil
 	}
@@ -255,3 +403,19 @@ func validateZones(zones []string, zoneOptions *[]provideroptions.AvailabilityZo
 	}
 	return nil
 }
+
+// knownStrings returns the elements of a string list, or false if any of them isn't known
+func knownStrings(l types.List) ([]string, bool) {
+	if l.IsUnknown() {
+		return nil, false
+	}
+	res := []string{}
+	for _, v := range l.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		res = append(res, s.ValueString())
+	}
+	return res, true
+}
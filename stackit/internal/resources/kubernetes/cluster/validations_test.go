@@ -1,10 +1,13 @@
 package cluster
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/Masterminds/semver"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func Test_maxVersionOption(t *testing.T) {
@@ -53,3 +56,31 @@ func Test_maxVersionOption(t *testing.T) {
 		})
 	}
 }
+
+func Test_knownStrings(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name   string
+		list   types.List
+		want   []string
+		wantOK bool
+	}{
+		{"known", types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1"), types.StringValue("eu01-2")}), []string{"eu01-1", "eu01-2"}, true},
+		{"null", types.ListNull(types.StringType), []string{}, true},
+		{"unknown list", types.ListUnknown(types.StringType), nil, false},
+		{"unknown element", types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1"), types.StringUnknown()}), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := knownStrings(tt.list)
+			if ok != tt.wantOK || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("knownStrings() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/flavors"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/instance"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/mongodb-flex/v1.0/versions"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/pkg/errors"
@@ -39,6 +44,72 @@ func (i *Instance) setDefaults() {
 	}
 }
 
+// ModifyPlan validates the instance against the available options
+// so invalid values fail during plan instead of during apply
+// checks whose values aren't known yet are skipped
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, without changes or before the provider is configured
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	// only read the attributes which are validated, the others may not be known yet
+	var plan, config Instance
+	for name, v := range map[string]interface{}{
+		"project_id":   &plan.ProjectID,
+		"version":      &plan.Version,
+		"type":         &plan.Type,
+		"machine_type": &plan.MachineType,
+		"storage":      &plan.Storage,
+	} {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), v)...)
+	}
+	for name, v := range map[string]interface{}{
+		"version": &config.Version,
+		"type":    &config.Type,
+	} {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), v)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all options are listed per project
+	if plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	if plan.Version.IsUnknown() && config.Version.IsNull() {
+		plan.Version = types.StringValue(DefaultVersion)
+	}
+	if !plan.Version.IsUnknown() {
+		if err := r.validateVersion(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.Version.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "failed mongodb validation", err.Error())
+		}
+	}
+	if plan.Type.IsUnknown() && config.Type.IsNull() {
+		plan.Type = types.StringValue(DefaultType)
+	}
+	if !plan.MachineType.IsUnknown() && !plan.Type.IsUnknown() {
+		if err := r.validateMachineType(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), plan.Type.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("machine_type"), "failed mongodb validation", err.Error())
+		}
+	}
+
+	// the storage options depend on the machine type
+	if plan.MachineType.IsUnknown() || plan.Storage.IsNull() || plan.Storage.IsUnknown() {
+		return
+	}
+	storage := Storage{}
+	resp.Diagnostics.Append(plan.Storage.As(ctx, &storage, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || storage.Class.IsUnknown() || storage.Size.IsUnknown() {
+		return
+	}
+	if err := r.validateStorage(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), storage); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage"), "failed mongodb validation", err.Error())
+	}
+}
+
 func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data Instance) error {
 	if err := r.validateVersion(ctx, diags, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
 		return err
@@ -64,9 +135,15 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data In
 }
 
 func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics, projectID, version string) error {
-	res, err := r.client.MongoDBFlex.Versions.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
-		return errors.Wrap(agg, "failed validating version")
+	res, err := cache.Get(cache.For(r.client), cache.MongoDBFlexVersions, projectID, func() (*versions.ListResponse, error) {
+		res, err := r.client.MongoDBFlex.Versions.List(ctx, projectID)
+		if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed validating version")
 	}
 
 	opts := ""
@@ -80,9 +157,15 @@ func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics,
 }
 
 func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnostics, projectID, flavorID, serviceType string) error {
-	res, err := r.client.MongoDBFlex.Flavors.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
-		return errors.Wrap(agg, "failed validating machine type (flavors)")
+	res, err := cache.Get(cache.For(r.client), cache.MongoDBFlexFlavors, projectID, func() (*flavors.ListResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.List(ctx, projectID)
+		if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed validating machine type (flavors)")
 	}
 
 	opts := ""
@@ -118,9 +201,15 @@ func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnosti
 }
 
 func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics, projectID, machineType string, storage Storage) error {
-	res, err := r.client.MongoDBFlex.Flavors.GetStorageOptions(ctx, projectID, machineType)
-	if agg := common.Validate(diags, res, err, "JSON200.StorageRange"); agg != nil {
-		return errors.Wrap(agg, "failed validating storage range")
+	res, err := cache.Get(cache.For(r.client), cache.MongoDBFlexStorages, projectID+"/"+machineType, func() (*flavors.GetStorageOptionsResponse, error) {
+		res, err := r.client.MongoDBFlex.Flavors.GetStorageOptions(ctx, projectID, machineType)
+		if agg := common.Validate(diags, res, err, "JSON200.StorageRange"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed validating storage range")
 	}
 
 	size := storage.Size.ValueInt64()
@@ -0,0 +1,225 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	iaas_area "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkArea
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ranges := iaas_area.V1NetworkRangeList{}
+	for _, p := range toStrings(ctx, plan.NetworkRanges, &resp.Diagnostics) {
+		ranges = append(ranges, iaas_area.V1NetworkRange{Prefix: p})
+	}
+	body := iaas_area.V1CreateAreaJSONRequestBody{
+		Name: plan.Name.ValueString(),
+		AddressFamily: iaas_area.V1CreateAreaAddressFamily{
+			Ipv4: &iaas_area.V1CreateAreaIPv4{
+				NetworkRanges:    ranges,
+				TransferNetwork:  plan.TransferNetwork.ValueString(),
+				DefaultPrefixLen: intPointer(plan.DefaultPrefixLen),
+				MinPrefixLen:     intPointer(plan.MinPrefixLen),
+				MaxPrefixLen:     intPointer(plan.MaxPrefixLen),
+			},
+		},
+	}
+	if !plan.DefaultNameservers.IsNull() {
+		ns := toStrings(ctx, plan.DefaultNameservers, &resp.Diagnostics)
+		body.AddressFamily.Ipv4.DefaultNameservers = &ns
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(plan.OrganizationID.ValueString())
+	res, err := r.client.IAAS.Area.V1CreateArea(ctx, organizationID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating network area %s", body.Name), agg.Error())
+		return
+	}
+
+	// set the ID right away to keep track of the area if waiting fails
+	areaID := res.JSON200.AreaID
+	plan.ID = types.StringValue(areaID.String())
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), plan.OrganizationID)...)
+
+	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	wr, err := createWaitHandler(ctx, r.client.IAAS.Area, organizationID, areaID).SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed validating network area %s creation", body.Name), err.Error())
+		return
+	}
+
+	a, ok := wr.(iaas_area.V1NetworkArea)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas_area.V1NetworkArea")
+		return
+	}
+
+	toState(ctx, a, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkArea
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(state.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(state.ID.ValueString())
+
+	res, err := r.client.IAAS.Area.V1GetArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading network area", agg.Error())
+		return
+	}
+
+	toState(ctx, *res.JSON200, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkArea
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(state.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(state.ID.ValueString())
+
+	// network ranges are added and removed individually
+	if !plan.NetworkRanges.Equal(state.NetworkRanges) {
+		current, err := r.client.IAAS.Area.V1ListNetworkRangesOfArea(ctx, organizationID, areaID)
+		if agg := common.Validate(&resp.Diagnostics, current, err, "JSON200"); agg != nil {
+			resp.Diagnostics.AddError("failed reading network ranges", agg.Error())
+			return
+		}
+
+		add, remove := rangesDiff(current.JSON200.Items, toStrings(ctx, plan.NetworkRanges, &resp.Diagnostics))
+		if len(add) > 0 {
+			res, err := r.client.IAAS.Area.V1AddNetworkRangesToArea(ctx, organizationID, areaID, iaas_area.V1AddNetworkRangesToAreaJSONRequestBody{Ipv4: &add})
+			if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+				resp.Diagnostics.AddError("failed adding network ranges", agg.Error())
+				return
+			}
+		}
+		for _, id := range remove {
+			res, err := r.client.IAAS.Area.V1DeleteNetworkRangeFromArea(ctx, organizationID, areaID, id)
+			if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil && !clientValidate.StatusEquals(res, http.StatusNotFound) {
+				resp.Diagnostics.AddError("failed removing network range", agg.Error())
+				return
+			}
+		}
+	}
+
+	name := plan.Name.ValueString()
+	ns := toStrings(ctx, plan.DefaultNameservers, &resp.Diagnostics)
+	res, err := r.client.IAAS.Area.V1UpdateArea(ctx, organizationID, areaID, iaas_area.V1UpdateAreaJSONRequestBody{
+		Name: &name,
+		AddressFamily: &iaas_area.V1UpdateAreaAddressFamily{
+			Ipv4: &iaas_area.V1UpdateAreaIPv4{
+				DefaultNameservers: &ns,
+				DefaultPrefixLen:   intPointer(plan.DefaultPrefixLen),
+				MinPrefixLen:       intPointer(plan.MinPrefixLen),
+				MaxPrefixLen:       intPointer(plan.MaxPrefixLen),
+			},
+		},
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed updating network area", agg.Error())
+		return
+	}
+
+	// re-read to get the updated network ranges
+	get, err := r.client.IAAS.Area.V1GetArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, get, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed reading network area", agg.Error())
+		return
+	}
+
+	toState(ctx, *get.JSON200, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkArea
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(state.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(state.ID.ValueString())
+
+	res, err := r.client.IAAS.Area.V1DeleteArea(ctx, organizationID, areaID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting network area", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := deleteWaitHandler(ctx, r.client.IAAS.Area, organizationID, areaID).SetTimeout(timeout).WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify network area deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `organization_id,network_area_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,173 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	iaas_area "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/wait"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// network area states
+const (
+	stateCreated = "CREATED"
+	stateFailed  = "FAILED"
+)
+
+func toStrings(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	s := []string{}
+	if l.IsNull() || l.IsUnknown() {
+		return s
+	}
+	diags.Append(l.ElementsAs(ctx, &s, false)...)
+	return s
+}
+
+// toList returns the values as list
+// the current order is kept if it contains the same values
+func toList(ctx context.Context, current types.List, values []string) types.List {
+	if !current.IsNull() && !current.IsUnknown() {
+		var diags diag.Diagnostics
+		if c := toStrings(ctx, current, &diags); !diags.HasError() && sameValues(c, values) {
+			return current
+		}
+	}
+	elems := []attr.Value{}
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func sameValues(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	m := map[string]int{}
+	for _, v := range a {
+		m[v]++
+	}
+	for _, v := range b {
+		if m[v] == 0 {
+			return false
+		}
+		m[v]--
+	}
+	return true
+}
+
+// rangesDiff returns the prefixes to add and the range IDs to remove
+func rangesDiff(current []iaas_area.V1NetworkRange, planned []string) (add []iaas_area.V1NetworkRange, remove []uuid.UUID) {
+	existing := map[string]bool{}
+	for _, r := range current {
+		existing[r.Prefix] = true
+	}
+	wanted := map[string]bool{}
+	for _, p := range planned {
+		wanted[p] = true
+		if !existing[p] {
+			add = append(add, iaas_area.V1NetworkRange{Prefix: p})
+		}
+	}
+	for _, r := range current {
+		if !wanted[r.Prefix] && r.NetworkRangeID != nil {
+			remove = append(remove, *r.NetworkRangeID)
+		}
+	}
+	return add, remove
+}
+
+func toState(ctx context.Context, a iaas_area.V1NetworkArea, state *NetworkArea) {
+	state.ID = types.StringValue(a.AreaID.String())
+	state.Name = types.StringValue(a.Name)
+
+	if a.Ipv4 == nil {
+		return
+	}
+	v4 := a.Ipv4
+
+	ranges := []string{}
+	if v4.NetworkRanges != nil {
+		for _, r := range *v4.NetworkRanges {
+			ranges = append(ranges, r.Prefix)
+		}
+	}
+	state.NetworkRanges = toList(ctx, state.NetworkRanges, ranges)
+
+	if v4.TransferNetwork != nil {
+		state.TransferNetwork = types.StringValue(*v4.TransferNetwork)
+	}
+
+	state.DefaultNameservers = types.ListNull(types.StringType)
+	if v4.DefaultNameservers != nil && len(*v4.DefaultNameservers) > 0 {
+		state.DefaultNameservers = toList(ctx, state.DefaultNameservers, *v4.DefaultNameservers)
+	}
+
+	state.DefaultPrefixLen = int64Value(v4.DefaultPrefixLen)
+	state.MinPrefixLen = int64Value(v4.MinPrefixLen)
+	state.MaxPrefixLen = int64Value(v4.MaxPrefixLen)
+}
+
+func intPointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+func int64Value(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
+
+// createWaitHandler waits for the network area to be created and returns it
+func createWaitHandler(ctx context.Context, c *iaas_area.ClientWithResponses, organizationID, areaID uuid.UUID) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.V1GetArea(ctx, organizationID, areaID)
+		if err != nil {
+			return nil, false, err
+		}
+		if resp.StatusCode() >= http.StatusInternalServerError {
+			return nil, false, nil
+		}
+		if agg := validate.Response(resp, nil, "JSON200"); agg != nil {
+			return nil, false, agg
+		}
+
+		a := *resp.JSON200
+		switch a.State {
+		case stateCreated:
+			return a, true, nil
+		case stateFailed:
+			return nil, false, errors.New("network area is in state FAILED")
+		}
+		return nil, false, nil
+	})
+}
+
+// deleteWaitHandler waits for the network area to be deleted
+func deleteWaitHandler(ctx context.Context, c *iaas_area.ClientWithResponses, organizationID, areaID uuid.UUID) *wait.Handler {
+	return wait.New(func() (res interface{}, done bool, err error) {
+		resp, err := c.V1GetArea(ctx, organizationID, areaID)
+		if err != nil {
+			return nil, false, err
+		}
+		switch code := resp.StatusCode(); code {
+		case http.StatusNotFound:
+			return nil, true, nil
+		case http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden:
+			return nil, false, fmt.Errorf("received status code %d while waiting for deletion", code)
+		}
+		return nil, false, nil
+	})
+}
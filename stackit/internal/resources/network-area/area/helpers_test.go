@@ -0,0 +1,46 @@
+package area
+
+import (
+	"reflect"
+	"testing"
+
+	iaas_area "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+)
+
+func Test_rangesDiff(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	a, b := uuid.New(), uuid.New()
+	current := []iaas_area.V1NetworkRange{
+		{NetworkRangeID: &a, Prefix: "10.0.0.0/16"},
+		{NetworkRangeID: &b, Prefix: "10.1.0.0/16"},
+	}
+	tests := []struct {
+		name       string
+		planned    []string
+		wantAdd    []iaas_area.V1NetworkRange
+		wantRemove []uuid.UUID
+	}{
+		{"unchanged", []string{"10.1.0.0/16", "10.0.0.0/16"}, nil, nil},
+		{"add", []string{"10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"}, []iaas_area.V1NetworkRange{{Prefix: "10.2.0.0/16"}}, nil},
+		{"remove", []string{"10.0.0.0/16"}, nil, []uuid.UUID{b}},
+		{"replace", []string{"10.0.0.0/16", "10.3.0.0/16"}, []iaas_area.V1NetworkRange{{Prefix: "10.3.0.0/16"}}, []uuid.UUID{b}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := rangesDiff(current, tt.planned)
+			if !reflect.DeepEqual(add, tt.wantAdd) {
+				t.Errorf("rangesDiff() add = %v, want %v", add, tt.wantAdd)
+			}
+			if !reflect.DeepEqual(remove, tt.wantRemove) {
+				t.Errorf("rangesDiff() remove = %v, want %v", remove, tt.wantRemove)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package area_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_NetworkArea(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	org, ok := os.LookupEnv("ACC_TEST_ORGANIZATION_ID")
+	if !ok {
+		t.Skip("Skipping TestAcc_NetworkArea: ACC_TEST_ORGANIZATION_ID not specified")
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, org, `["10.0.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_area.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "organization_id", org),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "transfer_network", "192.168.0.0/24"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "default_nameservers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttrSet("stackit_network_area.example", "default_prefix_length"),
+					resource.TestCheckResourceAttrSet("stackit_network_area.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_network_area_route.example", "network_area_id", "stackit_network_area.example", "id"),
+					resource.TestCheckResourceAttr("stackit_network_area_route.example", "prefix", "172.16.0.0/24"),
+					resource.TestCheckResourceAttr("stackit_network_area_route.example", "next_hop", "10.0.0.5"),
+					resource.TestCheckResourceAttrSet("stackit_network_area_route.example", "id"),
+				),
+			},
+			// add a network range
+			{
+				Config: config(name, org, `["10.0.0.0/16", "10.1.0.0/16"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.#", "2"),
+					resource.TestCheckResourceAttr("stackit_network_area.example", "network_ranges.1", "10.1.0.0/16"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_network_area.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_network_area.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_network_area.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", org, id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				ResourceName: "stackit_network_area_route.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_network_area_route.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_network_area_route.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					area, ok := r.Primary.Attributes["network_area_id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute network_area_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", org, area, id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, org, ranges string) string {
+	return fmt.Sprintf(`
+	resource "stackit_network_area" "example" {
+		name                = "%s"
+		organization_id     = "%s"
+		network_ranges      = %s
+		transfer_network    = "192.168.0.0/24"
+		default_nameservers = ["8.8.8.8"]
+	}
+
+	resource "stackit_network_area_route" "example" {
+		organization_id = stackit_network_area.example.organization_id
+		network_area_id = stackit_network_area.example.id
+		prefix          = "172.16.0.0/24"
+		next_hop        = "10.0.0.5"
+	}
+	`,
+		name,
+		org,
+		ranges,
+	)
+}
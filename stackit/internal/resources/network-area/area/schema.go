@@ -0,0 +1,135 @@
+package area
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkArea is the schema model
+type NetworkArea struct {
+	ID                 types.String   `tfsdk:"id"`
+	OrganizationID     types.String   `tfsdk:"organization_id"`
+	Name               types.String   `tfsdk:"name"`
+	NetworkRanges      types.List     `tfsdk:"network_ranges"`
+	TransferNetwork    types.String   `tfsdk:"transfer_network"`
+	DefaultNameservers types.List     `tfsdk:"default_nameservers"`
+	DefaultPrefixLen   types.Int64    `tfsdk:"default_prefix_length"`
+	MinPrefixLen       types.Int64    `tfsdk:"min_prefix_length"`
+	MaxPrefixLen       types.Int64    `tfsdk:"max_prefix_length"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT network area (SNA). A network area connects the networks of multiple projects of an organization. "+
+			"Projects are added to an area by setting `network_area_id` on `stackit_project`, networks created in these projects use the network ranges of the area.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the network area ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "the ID of the organization the network area belongs to. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "the network area name",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"network_ranges": schema.ListAttribute{
+				Description: "the IPv4 ranges in CIDR notation from which project networks are allocated",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 64),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(validate.CIDR()),
+				},
+			},
+			"transfer_network": schema.StringAttribute{
+				Description: "the IPv4 range in CIDR notation used to connect the area with the STACKIT backbone. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.CIDR(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_nameservers": schema.ListAttribute{
+				Description: "the default nameservers of networks in the area",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					validate.NameServers(),
+				},
+			},
+			"default_prefix_length": schema.Int64Attribute{
+				Description: "the default prefix length of networks in the area",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"min_prefix_length": schema.Int64Attribute{
+				Description: "the minimal prefix length of networks in the area",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_prefix_length": schema.Int64Attribute{
+				Description: "the maximal prefix length of networks in the area",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, 29),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package route
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	iaas_area "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/area"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Route
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routes := iaas_area.V1RouteList{{
+		Prefix:  plan.Prefix.ValueString(),
+		Nexthop: plan.NextHop.ValueString(),
+	}}
+
+	organizationID, _ := uuid.Parse(plan.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(plan.NetworkAreaID.ValueString())
+
+	res, err := r.client.IAAS.Area.V1AddRoutesToArea(ctx, organizationID, areaID, iaas_area.V1AddRoutesToAreaJSONRequestBody{
+		Ipv4: &routes,
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON202"); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating route %s", plan.Prefix.ValueString()), agg.Error())
+		return
+	}
+	if len(res.JSON202.Items) != 1 || res.JSON202.Items[0].RouteID == nil {
+		resp.Diagnostics.AddError("failed creating route", fmt.Sprintf("expected 1 route with an ID in response, got %d routes", len(res.JSON202.Items)))
+		return
+	}
+
+	toState(res.JSON202.Items[0], &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toState(route iaas_area.V1Route, state *Route) {
+	if route.RouteID != nil {
+		state.ID = types.StringValue(route.RouteID.String())
+	}
+	state.Prefix = types.StringValue(route.Prefix)
+	state.NextHop = types.StringValue(route.Nexthop)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Route
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(state.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(state.NetworkAreaID.ValueString())
+	routeID, _ := uuid.Parse(state.ID.ValueString())
+
+	res, err := r.client.IAAS.Area.V1GetRouteOfArea(ctx, organizationID, areaID, routeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading route", agg.Error())
+		return
+	}
+
+	toState(*res.JSON200, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+// routes can't be updated, all attributes require the resource to be recreated
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Route
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Route
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationID, _ := uuid.Parse(state.OrganizationID.ValueString())
+	areaID, _ := uuid.Parse(state.NetworkAreaID.ValueString())
+	routeID, _ := uuid.Parse(state.ID.ValueString())
+
+	res, err := r.client.IAAS.Area.V1DeleteRouteFromArea(ctx, organizationID, areaID, routeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting route", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `organization_id,network_area_id,route_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_area_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
+}
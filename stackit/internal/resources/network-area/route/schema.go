@@ -0,0 +1,83 @@
+package route
+
+import (
+	"context"
+	"fmt"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Route is the schema model
+type Route struct {
+	ID             types.String `tfsdk:"id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	NetworkAreaID  types.String `tfsdk:"network_area_id"`
+	Prefix         types.String `tfsdk:"prefix"`
+	NextHop        types.String `tfsdk:"next_hop"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a static route of a STACKIT network area\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the route ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "the ID of the organization the network area belongs to. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_area_id": schema.StringAttribute{
+				Description: "the network area ID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "the destination of the route in CIDR notation. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.CIDR(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"next_hop": schema.StringAttribute{
+				Description: "the IP address traffic for `prefix` is forwarded to. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.IsIP, "validate string is IP"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
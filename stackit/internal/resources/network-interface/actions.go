@@ -0,0 +1,209 @@
+package networkinterface
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan NetworkInterface
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nicSecurity := plan.NicSecurity.ValueBool()
+	body := iaas.CreateNetworkInterfaceRequest{
+		Name:        plan.Name.ValueStringPointer(),
+		NicSecurity: &nicSecurity,
+	}
+	if !plan.IPv4.IsUnknown() {
+		body.IPv4 = plan.IPv4.ValueStringPointer()
+	}
+	if !plan.IPv6.IsUnknown() {
+		body.IPv6 = plan.IPv6.ValueStringPointer()
+	}
+	if !plan.AllowedAddresses.IsNull() {
+		body.AllowedAddresses = toStrings(ctx, plan.AllowedAddresses, &resp.Diagnostics)
+	}
+	if !plan.SecurityGroupIDs.IsUnknown() && !plan.SecurityGroupIDs.IsNull() {
+		body.SecurityGroups = toStrings(ctx, plan.SecurityGroupIDs, &resp.Diagnostics)
+	}
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.CreateNetworkInterface(ctx, plan.ProjectID.ValueString(), plan.NetworkID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed creating network interface", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toStrings(ctx context.Context, l types.List, diags *diag.Diagnostics) *[]string {
+	s := []string{}
+	diags.Append(l.ElementsAs(ctx, &s, false)...)
+	return &s
+}
+
+func toList(s *[]string) types.List {
+	values := []attr.Value{}
+	if s != nil {
+		for _, v := range *s {
+			values = append(values, types.StringValue(v))
+		}
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+func toState(nic iaas.NetworkInterface, state *NetworkInterface) {
+	state.ID = types.StringValue(nic.ID)
+	state.NetworkID = types.StringValue(nic.NetworkID)
+	state.Name = types.StringNull()
+	if nic.Name != nil && *nic.Name != "" {
+		state.Name = types.StringValue(*nic.Name)
+	}
+	state.IPv4 = types.StringPointerValue(nic.IPv4)
+	state.IPv6 = types.StringPointerValue(nic.IPv6)
+	state.Mac = types.StringPointerValue(nic.Mac)
+	state.Device = types.StringPointerValue(nic.Device)
+	state.Status = types.StringValue(nic.Status)
+	if nic.NicSecurity != nil {
+		state.NicSecurity = types.BoolValue(*nic.NicSecurity)
+	}
+
+	state.AllowedAddresses = types.ListNull(types.StringType)
+	if nic.AllowedAddresses != nil && len(*nic.AllowedAddresses) > 0 {
+		state.AllowedAddresses = toList(nic.AllowedAddresses)
+	}
+	state.SecurityGroupIDs = toList(nic.SecurityGroups)
+
+	state.Labels = nil
+	if nic.Labels != nil && len(*nic.Labels) > 0 {
+		state.Labels = *nic.Labels
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state NetworkInterface
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetNetworkInterface(ctx, state.ProjectID.ValueString(), state.NetworkID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading network interface", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state NetworkInterface
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	nicSecurity := plan.NicSecurity.ValueBool()
+	labels := plan.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	body := iaas.UpdateNetworkInterfaceRequest{
+		Name:             &name,
+		NicSecurity:      &nicSecurity,
+		Labels:           &labels,
+		AllowedAddresses: toStrings(ctx, plan.AllowedAddresses, &resp.Diagnostics),
+	}
+	if !plan.SecurityGroupIDs.IsUnknown() {
+		body.SecurityGroups = toStrings(ctx, plan.SecurityGroupIDs, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.UpdateNetworkInterface(ctx, state.ProjectID.ValueString(), state.NetworkID.ValueString(), state.ID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed updating network interface", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state NetworkInterface
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.DeleteNetworkInterface(ctx, state.ProjectID.ValueString(), state.NetworkID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting network interface", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,network_id,network_interface_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
+}
@@ -0,0 +1,114 @@
+package networkinterface_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_NetworkInterface(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, "10.0.0.100/32"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "name", name),
+					resource.TestCheckResourceAttrPair("stackit_network_interface.example", "network_id", "stackit_network.example", "id"),
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "ipv4", "10.0.0.10"),
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "allowed_addresses.0", "10.0.0.100/32"),
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_network_interface.example", "security_group_ids.0", "stackit_security_group.example", "id"),
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "nic_security", "true"),
+					resource.TestCheckResourceAttrSet("stackit_network_interface.example", "mac"),
+					resource.TestCheckResourceAttrPair("stackit_public_ip.example", "network_interface_id", "stackit_network_interface.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_public_ip.example", "ip"),
+				),
+			},
+			// update allowed addresses
+			{
+				Config: config(name, "10.0.0.101/32"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network_interface.example", "allowed_addresses.0", "10.0.0.101/32"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_network_interface.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_network_interface.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_network_interface.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					network, ok := r.Primary.Attributes["network_id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute network_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), network, id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, allowed string) string {
+	return fmt.Sprintf(`
+	resource "stackit_network" "example" {
+		name       = "%[1]s"
+		project_id = "%[2]s"
+		ipv4 = {
+			prefix = "10.0.0.0/24"
+		}
+	}
+
+	resource "stackit_security_group" "example" {
+		name       = "%[1]s"
+		project_id = "%[2]s"
+	}
+
+	resource "stackit_network_interface" "example" {
+		name               = "%[1]s"
+		project_id         = "%[2]s"
+		network_id         = stackit_network.example.id
+		ipv4               = "10.0.0.10"
+		allowed_addresses  = ["%[3]s"]
+		security_group_ids = [stackit_security_group.example.id]
+	}
+
+	resource "stackit_public_ip" "example" {
+		project_id           = "%[2]s"
+		network_interface_id = stackit_network_interface.example.id
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		allowed,
+	)
+}
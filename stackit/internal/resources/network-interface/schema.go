@@ -0,0 +1,152 @@
+package networkinterface
+
+import (
+	"context"
+	"fmt"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkInterface is the schema model
+type NetworkInterface struct {
+	ID               types.String      `tfsdk:"id"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	NetworkID        types.String      `tfsdk:"network_id"`
+	Name             types.String      `tfsdk:"name"`
+	IPv4             types.String      `tfsdk:"ipv4"`
+	IPv6             types.String      `tfsdk:"ipv6"`
+	AllowedAddresses types.List        `tfsdk:"allowed_addresses"`
+	SecurityGroupIDs types.List        `tfsdk:"security_group_ids"`
+	NicSecurity      types.Bool        `tfsdk:"nic_security"`
+	Labels           map[string]string `tfsdk:"labels"`
+	Mac              types.String      `tfsdk:"mac"`
+	Device           types.String      `tfsdk:"device"`
+	Status           types.String      `tfsdk:"status"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT network interface. The interface can be attached to a server using `network_interface_ids` of `stackit_server`\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the network interface ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "the ID of the network the interface belongs to. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.NetworkID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "the network interface name",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"ipv4": schema.StringAttribute{
+				Description: "the fixed IPv4 address of the interface. If not set, an address of the network is assigned. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.IsIP, "validate string is IP"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ipv6": schema.StringAttribute{
+				Description: "the fixed IPv6 address of the interface. If not set, an address of the network is assigned if the network has an IPv6 prefix. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.StringWith(clientValidate.IsIP, "validate string is IP"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_addresses": schema.ListAttribute{
+				Description: "additional address ranges in CIDR notation which are allowed to send traffic from the interface, for example a virtual IP",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.CIDR()),
+				},
+			},
+			"security_group_ids": schema.ListAttribute{
+				Description: "the IDs of the security groups applied to the interface. If not set, the default security group of the project is applied",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(validate.UUID()),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nic_security": schema.BoolAttribute{
+				Description: "specifies if security groups and address spoofing protection are applied to the interface. Default is `true`",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"labels": schema.MapAttribute{
+				Description: "labels of the network interface",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"mac": schema.StringAttribute{
+				Description: "the MAC address of the interface",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device": schema.StringAttribute{
+				Description: "the ID of the server the interface is attached to",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "the network interface status",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -1,25 +1,23 @@
 package network
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"net/http"
-	"reflect"
-	"sort"
-	"strconv"
 	"strings"
 	"time"
 
+	iaas_network "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	iaas_network "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
-	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 )
 
 // Create - lifecycle function
@@ -46,42 +44,44 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 }
 
 func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateResponse, plan Network) Network {
-	var ns iaas_network.V1Nameserver
-
-	for _, i := range plan.NameServers.Elements() {
-		if i.IsNull() || i.IsUnknown() {
-			continue
-		}
-
-		nsVal, err := common.ToString(context.TODO(), i)
-		if err != nil {
-			continue
-		}
-
-		ns = append(ns, nsVal)
+	v4, diags := addressFamily(ctx, plan.IPv4)
+	resp.Diagnostics.Append(diags...)
+	v6, diags := addressFamily(ctx, plan.IPv6)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return plan
 	}
 
-	// ensure we have the Nameservers sorted
-	sort.Strings(ns)
+	// support the deprecated top level attributes
+	if v4.NameServers.IsNull() || v4.NameServers.IsUnknown() {
+		v4.NameServers = plan.NameServers
+	}
+	if v4.PrefixLength.IsNull() || v4.PrefixLength.IsUnknown() {
+		v4.PrefixLength = plan.PrefixLengthV4
+	}
 
-	pl := int(plan.PrefixLengthV4.ValueInt64())
 	name := plan.Name.ValueString()
-
-	body := iaas_network.V1CreateNetworkJSONRequestBody{
-		Name: name,
-		AddressFamily: &iaas_network.V1CreateNetworkAddressFamily{
-			Ipv4: &iaas_network.V1CreateNetworkIPv4{
-				Nameservers:  &ns,
-				PrefixLength: &pl,
-			},
+	routed := plan.Routed.ValueBool()
+	body := createNetworkBody{
+		Name:   name,
+		Routed: &routed,
+		AddressFamily: &createAddressFamily{
+			IPv4: v4.toCreateBody(),
+			IPv6: v6.toCreateBody(),
 		},
 	}
 
-	projectID, _ := uuid.Parse(plan.ProjectID.String())
-
-	res, err := r.client.IAAS.Network.V1CreateNetwork(ctx, projectID, body)
+	b, err := json.Marshal(body)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("failed creating network %s", body.Name), err.Error())
+		resp.Diagnostics.AddError("failed encoding network request", err.Error())
+		return plan
+	}
+
+	projectID, _ := uuid.Parse(plan.ProjectID.ValueString())
+
+	res, err := r.client.IAAS.Network.V1CreateNetworkWithBody(ctx, projectID, "application/json", bytes.NewReader(b))
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating network %s", name), agg.Error())
 		return plan
 	}
 
@@ -93,7 +93,7 @@ func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateRespon
 	process := res.WaitHandler(ctx, r.client.IAAS.Network, projectID, name).SetTimeout(timeout)
 	wr, err := process.WaitWithContext(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(fmt.Sprintf("failed validating network %s creation", body.Name), err.Error())
+		resp.Diagnostics.AddError(fmt.Sprintf("failed validating network %s creation", name), err.Error())
 		return plan
 	}
 
@@ -103,25 +103,31 @@ func (r Resource) createNetwork(ctx context.Context, resp *resource.CreateRespon
 		return plan
 	}
 
-	prefixes := make([]attr.Value, 0)
+	plan.ProjectID = types.StringValue(projectID.String())
+	if found := r.readNetwork(ctx, &resp.Diagnostics, projectID, network.NetworkID, &plan); !found {
+		resp.Diagnostics.AddError("failed reading network", fmt.Sprintf("network %s couldn't be found after creation", network.NetworkID))
+	}
+	return plan
+}
 
-	if network.Prefixes != nil && len(*network.Prefixes) > 0 {
-		for _, pr := range *network.Prefixes {
-			prefixes = append(prefixes, types.StringValue(pr))
+// readNetwork fetches the network and updates the given model
+// returns false if the network wasn't found
+func (r Resource) readNetwork(ctx context.Context, diags *diag.Diagnostics, projectID, networkID uuid.UUID, n *Network) bool {
+	res, err := r.client.IAAS.Network.V1GetNetwork(ctx, projectID, networkID)
+	if agg := common.Validate(diags, res, err, "JSON200"); agg != nil {
+		if validate.StatusEquals(res, http.StatusNotFound) {
+			return false
 		}
+		diags.AddError("failed reading network", agg.Error())
+		return true
 	}
 
-	plan.ID = types.StringValue(network.NetworkID.String())
-	plan.PublicIp = types.StringPointerValue(network.PublicIp)
-	plan.Prefixes = types.ListValueMust(types.StringType, prefixes)
-	plan.ProjectID = types.StringValue(projectID.String())
-
-	return plan
+	diags.Append(toState(ctx, *res.JSON200, res.Body, n)...)
+	return true
 }
 
 // Read - lifecycle function
 func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	c := r.client
 	var state Network
 
 	diags := req.State.Get(ctx, &state)
@@ -133,65 +139,15 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	projectID, _ := uuid.Parse(state.ProjectID.ValueString())
 	networkID, _ := uuid.Parse(state.ID.ValueString())
 
-	res, err := c.IAAS.Network.V1GetNetwork(ctx, projectID, networkID)
-	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
-		if validate.StatusEquals(res, http.StatusNotFound) {
-			resp.State.RemoveResource(ctx)
-		}
-		resp.Diagnostics.AddError("failed reading project", agg.Error())
+	if found := r.readNetwork(ctx, &resp.Diagnostics, projectID, networkID, &state); !found {
+		resp.State.RemoveResource(ctx)
 		return
 	}
-
-	n := res.JSON200
-
-	prefixes := make([]attr.Value, 0)
-	if n.Prefixes != nil && len(*n.Prefixes) > 0 {
-		for _, pr := range *n.Prefixes {
-			prefixes = append(prefixes, types.StringValue(pr))
-		}
-	}
-
-	// ensure we have the nameservers sorted
-
-	nameservers := make([]attr.Value, 0)
-	if n.Nameservers != nil && len(*n.Nameservers) > 0 {
-		nsData := *n.Nameservers
-		sort.Strings(nsData)
-
-		for _, ns := range nsData {
-			nameservers = append(nameservers, types.StringValue(ns))
-		}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	state.ID = types.StringValue(n.NetworkID.String())
 	state.ProjectID = types.StringValue(projectID.String())
-	state.Name = types.StringValue(n.Name)
-	state.PublicIp = types.StringPointerValue(n.PublicIp)
-	state.Prefixes = types.ListValueMust(types.StringType, prefixes)
-	state.NameServers = types.ListValueMust(types.StringType, nameservers)
-
-	// get the Prefix Length in a hacky way, otherwise fall back to default
-	if n.Prefixes != nil && len(*n.Prefixes) > 0 {
-		prefixData := *n.Prefixes
-
-		cidrSplit := strings.Split(prefixData[0], "/")
-		if len(cidrSplit) != 2 {
-			resp.Diagnostics.AddError("Processing CIDR Prefix Length",
-				"Processing CIDR Prefix Length")
-			return
-		}
-
-		prefixLength, err := strconv.ParseInt(cidrSplit[1], 10, 64)
-		if err != nil {
-			resp.Diagnostics.AddError("Processing CIDR Prefix Length", err.Error())
-			return
-		}
-
-		state.PrefixLengthV4 = types.Int64Value(prefixLength)
-	} else {
-		state.PrefixLengthV4 = types.Int64Value(25)
-	}
-
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -224,6 +180,13 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 		return
 	}
 
+	projectID, _ := uuid.Parse(state.ProjectID.ValueString())
+	networkID, _ := uuid.Parse(state.ID.ValueString())
+	r.readNetwork(ctx, &resp.Diagnostics, projectID, networkID, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// update state
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -233,36 +196,59 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 }
 
 func (r Resource) updateNetwork(ctx context.Context, plan, state Network, resp *resource.UpdateResponse) {
-	if plan.Name.Equal(state.Name) && reflect.DeepEqual(plan.NameServers, state.NameServers) {
+	planV4, diags := addressFamily(ctx, plan.IPv4)
+	resp.Diagnostics.Append(diags...)
+	planV6, diags := addressFamily(ctx, plan.IPv6)
+	resp.Diagnostics.Append(diags...)
+	stateV4, diags := addressFamily(ctx, state.IPv4)
+	resp.Diagnostics.Append(diags...)
+	stateV6, diags := addressFamily(ctx, state.IPv6)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ns := make([]iaas_network.V1IP, 0)
-	for _, s := range plan.NameServers.Elements() {
-		if s.IsNull() || s.IsUnknown() {
-			continue
-		}
+	// support the deprecated top level attribute
+	ns, ok := knownList(planV4.NameServers)
+	if !ok {
+		ns, ok = knownList(plan.NameServers)
+	}
+	current, _ := knownList(stateV4.NameServers)
+	nsChanged := ok && !equalStrings(ns, current)
+
+	nsV6, okV6 := knownList(planV6.NameServers)
+	currentV6, _ := knownList(stateV6.NameServers)
+	nsV6Changed := okV6 && !equalStrings(nsV6, currentV6)
 
-		ns = append(ns, s.String())
+	if plan.Name.Equal(state.Name) && !nsChanged && !nsV6Changed {
+		return
 	}
 
 	name := plan.Name.ValueString()
-
-	body := iaas_network.V1UpdateNetworkJSONBody{
-		Name: &name,
-		AddressFamily: &iaas_network.V1UpdateNetworkAddressFamily{
-			Ipv4: &iaas_network.V1UpdateNetworkIPv4{
-				Nameservers: &ns,
-			},
-		},
+	body := iaas_network.V1UpdateNetworkJSONRequestBody{
+		Name:          &name,
+		AddressFamily: &iaas_network.V1UpdateNetworkAddressFamily{},
+	}
+	if nsChanged {
+		body.AddressFamily.Ipv4 = &iaas_network.V1UpdateNetworkIPv4{
+			Nameservers: &ns,
+		}
+	}
+	if nsV6Changed {
+		body.AddressFamily.Ipv6 = &iaas_network.V1UpdateNetworkIPv6{
+			Nameservers: &nsV6,
+		}
+	}
+	if body.AddressFamily.Ipv4 == nil && body.AddressFamily.Ipv6 == nil {
+		body.AddressFamily = nil
 	}
 
 	projectID, _ := uuid.Parse(state.ProjectID.ValueString())
 	networkID, _ := uuid.Parse(state.ID.ValueString())
 
-	res, err := r.client.IAAS.Network.V1UpdateNetwork(ctx, projectID, networkID, iaas_network.V1UpdateNetworkJSONRequestBody(body))
-	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
-		resp.Diagnostics.AddError("failed updating project", agg.Error())
+	res, err := r.client.IAAS.Network.V1UpdateNetwork(ctx, projectID, networkID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError("failed updating network", agg.Error())
 		return
 	}
 }
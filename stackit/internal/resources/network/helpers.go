@@ -0,0 +1,241 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+
+	iaas_network "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// createNetworkBody is the network create request
+// it extends iaas_network.V1CreateNetworkJSONRequestBody with the prefix, gateway and routed fields
+type createNetworkBody struct {
+	Name          string               `json:"name"`
+	Routed        *bool                `json:"routed,omitempty"`
+	AddressFamily *createAddressFamily `json:"addressFamily,omitempty"`
+}
+
+type createAddressFamily struct {
+	IPv4 *createAddressFamilyIP `json:"ipv4,omitempty"`
+	IPv6 *createAddressFamilyIP `json:"ipv6,omitempty"`
+}
+
+type createAddressFamilyIP struct {
+	Prefix       *string   `json:"prefix,omitempty"`
+	PrefixLength *int      `json:"prefixLength,omitempty"`
+	Gateway      *string   `json:"gateway,omitempty"`
+	Nameservers  *[]string `json:"nameservers,omitempty"`
+}
+
+// networkDetails holds the network fields not covered by iaas_network.V1Network
+type networkDetails struct {
+	Gateway   *string `json:"gateway,omitempty"`
+	GatewayV6 *string `json:"gatewayv6,omitempty"`
+	Routed    *bool   `json:"routed,omitempty"`
+}
+
+// addressFamily returns the address family of the given object
+// null or unknown objects result in an address family with null attributes
+func addressFamily(ctx context.Context, o types.Object) (AddressFamily, diag.Diagnostics) {
+	af := AddressFamily{
+		Prefix:       types.StringNull(),
+		PrefixLength: types.Int64Null(),
+		Gateway:      types.StringNull(),
+		NameServers:  types.ListNull(types.StringType),
+	}
+	if o.IsNull() || o.IsUnknown() {
+		return af, nil
+	}
+	diags := o.As(ctx, &af, basetypes.ObjectAsOptions{})
+	return af, diags
+}
+
+// toCreateBody converts an address family to the create request
+// returns nil if no attribute was configured
+func (af AddressFamily) toCreateBody() *createAddressFamilyIP {
+	b := &createAddressFamilyIP{}
+	configured := false
+	if v, ok := knownString(af.Prefix); ok {
+		b.Prefix = &v
+		configured = true
+	}
+	if !af.PrefixLength.IsNull() && !af.PrefixLength.IsUnknown() {
+		pl := int(af.PrefixLength.ValueInt64())
+		b.PrefixLength = &pl
+		configured = true
+	}
+	if v, ok := knownString(af.Gateway); ok {
+		b.Gateway = &v
+		configured = true
+	}
+	if ns, ok := knownList(af.NameServers); ok {
+		b.Nameservers = &ns
+		configured = true
+	}
+	if !configured {
+		return nil
+	}
+	return b
+}
+
+func knownString(v types.String) (string, bool) {
+	if v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+	return v.ValueString(), true
+}
+
+// knownList returns the sorted string elements of a known list
+func knownList(l types.List) ([]string, bool) {
+	if l.IsNull() || l.IsUnknown() {
+		return nil, false
+	}
+	res := []string{}
+	for _, v := range l.Elements() {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		s, err := common.ToString(context.TODO(), v)
+		if err != nil {
+			continue
+		}
+		res = append(res, s)
+	}
+	sort.Strings(res)
+	return res, true
+}
+
+// listValue returns the given values as list
+// the order of current is kept if it holds the same values
+func listValue(current types.List, values []string) types.List {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+
+	if cur, ok := knownList(current); ok && equalStrings(cur, sorted) {
+		return current
+	}
+
+	return stringList(sorted)
+}
+
+func stringList(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func deref(l *[]string) []string {
+	if l == nil {
+		return []string{}
+	}
+	return *l
+}
+
+// prefixLength returns the prefix length of the first prefix
+func prefixLength(prefixes []string) types.Int64 {
+	if len(prefixes) == 0 {
+		return types.Int64Null()
+	}
+	_, n, err := net.ParseCIDR(prefixes[0])
+	if err != nil {
+		return types.Int64Null()
+	}
+	ones, _ := n.Mask.Size()
+	return types.Int64Value(int64(ones))
+}
+
+// toAddressFamily builds the ipv4 or ipv6 object from the API response
+// configured prefix and gateway values are kept to avoid diffs caused by notation
+func toAddressFamily(current AddressFamily, prefixes, nameservers []string, gateway *string) types.Object {
+	af := AddressFamily{
+		Prefix:       types.StringNull(),
+		PrefixLength: prefixLength(prefixes),
+		Gateway:      types.StringPointerValue(gateway),
+		NameServers:  listValue(current.NameServers, nameservers),
+	}
+	if len(prefixes) > 0 {
+		af.Prefix = types.StringValue(prefixes[0])
+	}
+	if v, ok := knownString(current.Prefix); ok && len(prefixes) > 0 {
+		af.Prefix = types.StringValue(v)
+	}
+	if v, ok := knownString(current.Gateway); ok && gateway != nil {
+		af.Gateway = types.StringValue(v)
+	}
+
+	return types.ObjectValueMust(addressFamilyType, map[string]attr.Value{
+		"prefix":        af.Prefix,
+		"prefix_length": af.PrefixLength,
+		"gateway":       af.Gateway,
+		"nameservers":   af.NameServers,
+	})
+}
+
+// toState updates the state with the network response
+func toState(ctx context.Context, n iaas_network.V1Network, raw []byte, state *Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	details := networkDetails{}
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &details); err != nil {
+			diags.AddError("failed parsing network details", err.Error())
+			return diags
+		}
+	}
+
+	v4, d := addressFamily(ctx, state.IPv4)
+	diags.Append(d...)
+	v6, d := addressFamily(ctx, state.IPv6)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	prefixes := deref(n.Prefixes)
+	prefixesV6 := deref(n.PrefixesV6)
+	nameservers := deref(n.Nameservers)
+	nameserversV6 := deref(n.NameserversV6)
+
+	state.ID = types.StringValue(n.NetworkID.String())
+	state.Name = types.StringValue(n.Name)
+	state.PublicIp = types.StringPointerValue(n.PublicIp)
+	state.Prefixes = stringList(prefixes)
+	state.PrefixesV6 = stringList(prefixesV6)
+	state.NameServers = listValue(state.NameServers, nameservers)
+	state.PrefixLengthV4 = prefixLength(prefixes)
+	state.IPv4 = toAddressFamily(v4, prefixes, nameservers, details.Gateway)
+
+	state.IPv6 = types.ObjectNull(addressFamilyType)
+	if len(prefixesV6) > 0 || len(nameserversV6) > 0 || details.GatewayV6 != nil {
+		state.IPv6 = toAddressFamily(v6, prefixesV6, nameserversV6, details.GatewayV6)
+	}
+
+	switch {
+	case details.Routed != nil:
+		state.Routed = types.BoolValue(*details.Routed)
+	case state.Routed.IsNull() || state.Routed.IsUnknown():
+		state.Routed = types.BoolValue(true)
+	}
+	return diags
+}
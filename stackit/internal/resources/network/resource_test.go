@@ -61,6 +61,61 @@ func TestAcc_Network(t *testing.T) {
 	})
 }
 
+func TestAcc_NetworkAddressFamily(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// explicit prefix
+			{
+				Config: configAddressFamily(name, `"8.8.8.8"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_network.example", "ipv4.prefix", "10.253.16.0/24"),
+					resource.TestCheckResourceAttr("stackit_network.example", "ipv4.prefix_length", "24"),
+					resource.TestCheckResourceAttr("stackit_network.example", "ipv4.nameservers.#", "1"),
+					resource.TestCheckResourceAttr("stackit_network.example", "prefixes.0", "10.253.16.0/24"),
+					resource.TestCheckResourceAttr("stackit_network.example", "routed", "true"),
+				),
+			},
+			// update nameservers and name in place
+			{
+				Config: configAddressFamily(name+"-new", `"8.8.8.8", "8.8.4.4"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_network.example", "name", name+"-new"),
+					resource.TestCheckResourceAttr("stackit_network.example", "ipv4.prefix", "10.253.16.0/24"),
+					resource.TestCheckResourceAttr("stackit_network.example", "ipv4.nameservers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func configAddressFamily(name, nameservers string) string {
+	return fmt.Sprintf(`
+resource "stackit_network" "example" {
+	project_id = "%s"
+	name       = "%s"
+	ipv4 = {
+		prefix      = "10.253.16.0/24"
+		nameservers = [%s]
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		nameservers,
+	)
+}
+
 func config(name string) string {
 	return fmt.Sprintf(`
 resource "stackit_network" "example" {
@@ -3,13 +3,22 @@ package network
 import (
 	"context"
 	"fmt"
+
 	iaas_network "github.com/SchwarzIT/community-stackit-go-client/pkg/services/iaas-api/v1/network"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -22,12 +31,31 @@ type Network struct {
 	Name           types.String   `tfsdk:"name"`
 	NameServers    types.List     `tfsdk:"nameservers"`
 	Prefixes       types.List     `tfsdk:"prefixes"`
+	PrefixesV6     types.List     `tfsdk:"prefixes_v6"`
 	PrefixLengthV4 types.Int64    `tfsdk:"prefix_length_v4"`
+	IPv4           types.Object   `tfsdk:"ipv4"`
+	IPv6           types.Object   `tfsdk:"ipv6"`
+	Routed         types.Bool     `tfsdk:"routed"`
 	PublicIp       types.String   `tfsdk:"public_ip"`
 	ProjectID      types.String   `tfsdk:"project_id"`
 	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
+// AddressFamily is the model of the ipv4 and ipv6 attributes
+type AddressFamily struct {
+	Prefix       types.String `tfsdk:"prefix"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	Gateway      types.String `tfsdk:"gateway"`
+	NameServers  types.List   `tfsdk:"nameservers"`
+}
+
+var addressFamilyType = map[string]attr.Type{
+	"prefix":        types.StringType,
+	"prefix_length": types.Int64Type,
+	"gateway":       types.StringType,
+	"nameservers":   types.ListType{ElemType: types.StringType},
+}
+
 // Schema returns terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -50,27 +78,62 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 			"nameservers": schema.ListAttribute{
-				Description: "List of DNS Servers/Nameservers.",
-				Required:    true,
-				ElementType: types.StringType,
+				DeprecationMessage: "This attribute is deprecated and will be removed in a future version of the provider. Please use `ipv4.nameservers` instead.",
+				Description:        "List of IPv4 DNS Servers/Nameservers.",
+				Optional:           true,
+				Computed:           true,
+				ElementType:        types.StringType,
 				Validators: []validator.List{
 					validate.NameServers(),
+					listvalidator.ConflictsWith(path.MatchRoot("ipv4").AtName("nameservers")),
 				},
 			},
 			"prefixes": schema.ListAttribute{
+				Description: "the IPv4 prefixes of the network",
 				Computed:    true,
 				ElementType: types.StringType,
 				Validators: []validator.List{
 					validate.Prefixes(),
 				},
 			},
-			"prefix_length_v4": schema.Int64Attribute{
-				Description: "prefix length",
-				Optional:    true,
+			"prefixes_v6": schema.ListAttribute{
+				Description: "the IPv6 prefixes of the network",
 				Computed:    true,
-				Default:     int64default.StaticInt64(25),
+				ElementType: types.StringType,
+			},
+			"prefix_length_v4": schema.Int64Attribute{
+				DeprecationMessage: "This attribute is deprecated and will be removed in a future version of the provider. Please use `ipv4.prefix_length` instead.",
+				Description:        "IPv4 prefix length",
+				Optional:           true,
+				Computed:           true,
 				Validators: []validator.Int64{
 					validate.PrefixLengthV4(),
+					int64validator.ConflictsWith(path.MatchRoot("ipv4")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ipv4": schema.SingleNestedAttribute{
+				Description: "IPv4 configuration of the network",
+				Optional:    true,
+				Computed:    true,
+				Attributes:  addressFamilySchema(4),
+			},
+			"ipv6": schema.SingleNestedAttribute{
+				Description: "IPv6 configuration of the network. Setting this attribute creates a dual-stack network.",
+				Optional:    true,
+				Computed:    true,
+				Attributes:  addressFamilySchema(6),
+			},
+			"routed": schema.BoolAttribute{
+				Description: "Specifies if the network is routed and has a gateway. Set to `false` for an isolated network without gateway. Default is `true`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
 				},
 			},
 			"public_ip": schema.StringAttribute{
@@ -95,3 +158,59 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 		},
 	}
 }
+
+func addressFamilySchema(version int) map[string]schema.Attribute {
+	prefixLengthValidator := validator.Int64(validate.PrefixLengthV4())
+	if version == 6 {
+		prefixLengthValidator = int64validator.Between(56, 64)
+	}
+
+	return map[string]schema.Attribute{
+		"prefix": schema.StringAttribute{
+			Description: fmt.Sprintf("the IPv%d prefix (CIDR) of the network. If not set, a prefix is chosen from the network area. Changing this value requires the resource to be recreated.", version),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				validate.StringWith(clientValidate.Prefix, "validate prefix"),
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("prefix_length")),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"prefix_length": schema.Int64Attribute{
+			Description: fmt.Sprintf("the IPv%d prefix length used to choose a prefix from the network area. Changing this value requires the resource to be recreated.", version),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.Int64{
+				prefixLengthValidator,
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"gateway": schema.StringAttribute{
+			Description: fmt.Sprintf("the IPv%d gateway of the network. If not set, the first IP of the prefix is used. Changing this value requires the resource to be recreated.", version),
+			Optional:    true,
+			Computed:    true,
+			Validators: []validator.String{
+				validate.StringWith(clientValidate.IsIP, "validate gateway IP"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"nameservers": schema.ListAttribute{
+			Description: fmt.Sprintf("List of IPv%d DNS Servers/Nameservers.", version),
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				validate.NameServers(),
+			},
+		},
+	}
+}
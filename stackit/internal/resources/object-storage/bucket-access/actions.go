@@ -0,0 +1,122 @@
+package bucketaccess
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketAccess
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apply(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.Name
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func apply(ctx context.Context, diags *diag.Diagnostics, plan BucketAccess) {
+	c := client(diags, plan)
+	if diags.HasError() {
+		return
+	}
+
+	st := statement(plan.Name.ValueString(), c.Bucket(), plan.Permission.ValueString(), plan.CredentialsGroupURNs)
+	if err := putStatement(ctx, c, st); err != nil {
+		diags.AddError("failed updating bucket policy", err.Error())
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketAccess
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := c.GetBucketPolicy(ctx)
+	if err != nil && !s3.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed reading bucket policy", err.Error())
+		return
+	}
+
+	// statements removed or changed to actions that don't match a permission level are recreated
+	permission, urns, found, err := findStatement(policy, state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading bucket policy", err.Error())
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = state.Name
+	state.Permission = types.StringValue(permission)
+	state.CredentialsGroupURNs = urns
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BucketAccess
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apply(ctx, &resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketAccess
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := deleteStatement(ctx, c, state.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed updating bucket policy", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func client(diags *diag.Diagnostics, b BucketAccess) *s3.Client {
+	c, err := s3.New(b.BucketURL.ValueString(), b.Region.ValueString(), b.AccessKey.ValueString(), b.SecretAccessKey.ValueString())
+	if err != nil {
+		diags.AddError("failed to initialize S3 client", err.Error())
+	}
+	return c
+}
@@ -0,0 +1,236 @@
+package bucketaccess
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+)
+
+// permission levels
+const (
+	PermissionRead  = "read"
+	PermissionWrite = "write"
+	PermissionAdmin = "admin"
+)
+
+const policyVersion = "2012-10-17"
+
+// permissions maps permission levels to the allowed S3 actions
+var permissions = map[string][]string{
+	PermissionRead: {
+		"s3:GetBucketLocation",
+		"s3:GetObject",
+		"s3:GetObjectVersion",
+		"s3:ListBucket",
+		"s3:ListBucketVersions",
+	},
+	PermissionWrite: {
+		"s3:AbortMultipartUpload",
+		"s3:DeleteObject",
+		"s3:GetBucketLocation",
+		"s3:GetObject",
+		"s3:GetObjectVersion",
+		"s3:ListBucket",
+		"s3:ListBucketMultipartUploads",
+		"s3:ListBucketVersions",
+		"s3:ListMultipartUploadParts",
+		"s3:PutObject",
+	},
+	PermissionAdmin: {
+		"s3:*",
+	},
+}
+
+// locks serializes policy changes per bucket
+// as multiple resources may update statements of the same policy in parallel
+var locks = struct {
+	sync.Mutex
+	buckets map[string]*sync.Mutex
+}{buckets: map[string]*sync.Mutex{}}
+
+func lock(bucket string) func() {
+	locks.Lock()
+	m, ok := locks.buckets[bucket]
+	if !ok {
+		m = &sync.Mutex{}
+		locks.buckets[bucket] = m
+	}
+	locks.Unlock()
+	m.Lock()
+	return m.Unlock
+}
+
+// statement returns the policy statement granting the permission to the credentials groups
+func statement(sid, bucket, permission string, urns []string) map[string]interface{} {
+	principals := append([]string{}, urns...)
+	sort.Strings(principals)
+	return map[string]interface{}{
+		"Sid":       sid,
+		"Effect":    "Allow",
+		"Principal": map[string]interface{}{"AWS": principals},
+		"Action":    permissions[permission],
+		"Resource": []string{
+			fmt.Sprintf("arn:aws:s3:::%s", bucket),
+			fmt.Sprintf("arn:aws:s3:::%s/*", bucket),
+		},
+	}
+}
+
+// parsePolicy parses a policy document, an empty document returns a new policy
+func parsePolicy(policy string) (map[string]interface{}, []interface{}, error) {
+	doc := map[string]interface{}{"Version": policyVersion}
+	if policy != "" {
+		if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+			return nil, nil, fmt.Errorf("failed parsing bucket policy: %w", err)
+		}
+	}
+	statements, _ := doc["Statement"].([]interface{})
+	return doc, statements, nil
+}
+
+func sidOf(st interface{}) string {
+	m, ok := st.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	sid, _ := m["Sid"].(string)
+	return sid
+}
+
+// setStatement replaces the statement with the same Sid or appends it
+func setStatement(policy string, st map[string]interface{}) (string, error) {
+	doc, statements, err := parsePolicy(policy)
+	if err != nil {
+		return "", err
+	}
+	replaced := false
+	for i, s := range statements {
+		if sidOf(s) == st["Sid"] {
+			statements[i] = st
+			replaced = true
+		}
+	}
+	if !replaced {
+		statements = append(statements, st)
+	}
+	doc["Statement"] = statements
+	b, err := json.Marshal(doc)
+	return string(b), err
+}
+
+// removeStatement removes the statement with the given Sid
+// and returns the remaining number of statements
+func removeStatement(policy, sid string) (string, int, error) {
+	doc, statements, err := parsePolicy(policy)
+	if err != nil {
+		return "", 0, err
+	}
+	remaining := []interface{}{}
+	for _, s := range statements {
+		if sidOf(s) != sid {
+			remaining = append(remaining, s)
+		}
+	}
+	doc["Statement"] = remaining
+	b, err := json.Marshal(doc)
+	return string(b), len(remaining), err
+}
+
+// findStatement returns the permission level and credentials group URNs of the statement with the given Sid
+// found is false if the statement doesn't exist or doesn't match a permission level
+func findStatement(policy, sid string) (permission string, urns []string, found bool, err error) {
+	_, statements, err := parsePolicy(policy)
+	if err != nil {
+		return "", nil, false, err
+	}
+	for _, s := range statements {
+		if sidOf(s) != sid {
+			continue
+		}
+		m := s.(map[string]interface{})
+		if p, ok := m["Principal"].(map[string]interface{}); ok {
+			urns = toStrings(p["AWS"])
+		}
+		actions := toStrings(m["Action"])
+		for level, preset := range permissions {
+			if equalSets(actions, preset) {
+				return level, urns, true, nil
+			}
+		}
+	}
+	return "", nil, false, nil
+}
+
+// toStrings converts a policy value which is either a string or a list of strings
+func toStrings(v interface{}) []string {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		res := []string{}
+		for _, s := range t {
+			if str, ok := s.(string); ok {
+				res = append(res, str)
+			}
+		}
+		return res
+	}
+	return nil
+}
+
+func equalSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := map[string]bool{}
+	for _, v := range a {
+		set[v] = true
+	}
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+	}
+	return true
+}
+
+// putStatement adds or updates the statement in the bucket policy
+func putStatement(ctx context.Context, c *s3.Client, st map[string]interface{}) error {
+	defer lock(c.Bucket())()
+
+	policy, err := c.GetBucketPolicy(ctx)
+	if err != nil && !s3.IsNotFound(err) {
+		return err
+	}
+	policy, err = setStatement(policy, st)
+	if err != nil {
+		return err
+	}
+	return c.PutBucketPolicy(ctx, policy)
+}
+
+// deleteStatement removes the statement from the bucket policy
+// the policy is deleted once no statements are left
+func deleteStatement(ctx context.Context, c *s3.Client, sid string) error {
+	defer lock(c.Bucket())()
+
+	policy, err := c.GetBucketPolicy(ctx)
+	if err != nil {
+		if s3.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	policy, remaining, err := removeStatement(policy, sid)
+	if err != nil {
+		return err
+	}
+	if remaining == 0 {
+		return c.DeleteBucketPolicy(ctx)
+	}
+	return c.PutBucketPolicy(ctx, policy)
+}
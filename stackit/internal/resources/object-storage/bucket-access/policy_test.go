@@ -0,0 +1,118 @@
+package bucketaccess
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3/s3test"
+)
+
+func Test_statements(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	urn := "urn:sgws:identity::project:group/reader"
+	other := `{"Version":"2012-10-17","Statement":[{"Sid":"custom","Effect":"Deny","Principal":"*","Action":"s3:DeleteObject","Resource":"arn:aws:s3:::logs/*"}]}`
+
+	// add to an existing policy
+	policy, err := setStatement(other, statement("readers", "logs", PermissionRead, []string{urn}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	permission, urns, found, err := findStatement(policy, "readers")
+	if err != nil || !found || permission != PermissionRead || len(urns) != 1 || urns[0] != urn {
+		t.Fatalf("findStatement() = %s, %v, %v, %v", permission, urns, found, err)
+	}
+
+	// replace statement
+	policy, err = setStatement(policy, statement("readers", "logs", PermissionWrite, []string{urn, "urn:other"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	permission, urns, found, _ = findStatement(policy, "readers")
+	if !found || permission != PermissionWrite || len(urns) != 2 {
+		t.Fatalf("findStatement() = %s, %v, %v", permission, urns, found)
+	}
+
+	// statements which don't match a permission level are not found
+	if _, _, found, _ := findStatement(policy, "custom"); found {
+		t.Error("findStatement() found custom statement")
+	}
+
+	// remove statement, other statements are kept
+	policy, remaining, err := removeStatement(policy, "readers")
+	if err != nil || remaining != 1 {
+		t.Fatalf("removeStatement() = %d, %v", remaining, err)
+	}
+	if _, _, found, _ := findStatement(policy, "readers"); found {
+		t.Error("findStatement() found removed statement")
+	}
+
+	// new policy
+	policy, err = setStatement("", statement("admins", "logs", PermissionAdmin, []string{urn}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if permission, _, found, _ := findStatement(policy, "admins"); !found || permission != PermissionAdmin {
+		t.Errorf("findStatement() = %s, %v", permission, found)
+	}
+}
+
+func Test_putStatement(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := s3test.NewServer(t, "logs")
+	c, err := s3.New(srv.BucketURL(), "", s3test.AccessKey, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// statements of parallel resources must not overwrite each other
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			st := statement(fmt.Sprintf("group%d", i), c.Bucket(), PermissionRead, []string{fmt.Sprintf("urn:group:%d", i)})
+			if err := putStatement(ctx, c, st); err != nil {
+				t.Error(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	policy, err := c.GetBucketPolicy(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		if _, _, found, _ := findStatement(policy, fmt.Sprintf("group%d", i)); !found {
+			t.Errorf("statement group%d not found", i)
+		}
+	}
+
+	// the policy is removed with the last statement
+	for i := 0; i < 5; i++ {
+		if err := deleteStatement(ctx, c, fmt.Sprintf("group%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := srv.Subresource("policy"); ok {
+		t.Error("expected bucket policy to be deleted")
+	}
+	if err := deleteStatement(ctx, c, "group0"); err != nil {
+		t.Errorf("deleteStatement() without policy error = %v", err)
+	}
+}
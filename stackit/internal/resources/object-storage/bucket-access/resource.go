@@ -0,0 +1,24 @@
+package bucketaccess
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// New returns a new configured resource
+func New() resource.Resource {
+	return &Resource{}
+}
+
+// Resource is the exported resource
+// the policy statement is managed through the S3 API of the bucket
+// and doesn't require the STACKIT API client
+type Resource struct{}
+
+var _ = resource.Resource(&Resource{})
+
+// Metadata returns data resource metadata
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = "stackit_object_storage_bucket_access"
+}
@@ -0,0 +1,95 @@
+package bucketaccess_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ObjectStorageBucketAccess(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.readers", "id", "readers"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.readers", "permission", "read"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.readers", "credentials_group_urns.#", "1"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.writers", "permission", "write"),
+				),
+			},
+			{
+				Config: config(name, "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.readers", "permission", "admin"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_access.writers", "permission", "write"),
+				),
+			},
+		},
+	})
+}
+
+func config(name, permission string) string {
+	return fmt.Sprintf(`
+resource "stackit_object_storage_credential" "admin" {
+	project_id = "%s"
+}
+
+resource "stackit_object_storage_credentials_group" "readers" {
+	project_id = stackit_object_storage_credential.admin.project_id
+	name       = "%sr"
+}
+
+resource "stackit_object_storage_credentials_group" "writers" {
+	project_id = stackit_object_storage_credential.admin.project_id
+	name       = "%sw"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+	project_id = stackit_object_storage_credential.admin.project_id
+	name       = "%s"
+}
+
+resource "stackit_object_storage_bucket_access" "readers" {
+	name                  = "readers"
+	bucket_url            = stackit_object_storage_bucket.example.path_style_url
+	access_key            = stackit_object_storage_credential.admin.access_key
+	secret_access_key     = stackit_object_storage_credential.admin.secret_access_key
+	permission            = "%s"
+	credentials_group_urns = [stackit_object_storage_credentials_group.readers.urn]
+}
+
+resource "stackit_object_storage_bucket_access" "writers" {
+	name                  = "writers"
+	bucket_url            = stackit_object_storage_bucket.example.path_style_url
+	access_key            = stackit_object_storage_credential.admin.access_key
+	secret_access_key     = stackit_object_storage_credential.admin.secret_access_key
+	permission            = "write"
+	credentials_group_urns = [stackit_object_storage_credentials_group.writers.urn]
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		name,
+		name,
+		permission,
+	)
+}
@@ -0,0 +1,110 @@
+package bucketaccess
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BucketAccess is the schema model
+type BucketAccess struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	BucketURL            types.String `tfsdk:"bucket_url"`
+	Region               types.String `tfsdk:"region"`
+	AccessKey            types.String `tfsdk:"access_key"`
+	SecretAccessKey      types.String `tfsdk:"secret_access_key"`
+	Permission           types.String `tfsdk:"permission"`
+	CredentialsGroupURNs []string     `tfsdk:"credentials_group_urns"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Grants credentials groups access to an Object Storage bucket.\n\n" +
+			"The resource maintains a single statement of the bucket policy, identified by `name`. " +
+			"Statements of other `stackit_object_storage_bucket_access` resources are kept, so multiple resources can grant access to the same bucket. " +
+			"Don't combine it with `stackit_object_storage_bucket_policy` on the same bucket, as that resource manages the whole policy.\n\n" +
+			"Permission levels:\n" +
+			"- `read`: list and download objects\n" +
+			"- `write`: `read`, plus upload and delete objects\n" +
+			"- `admin`: all S3 actions, including bucket configuration",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (the statement ID)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"name": schema.StringAttribute{
+				Description: "the policy statement ID (`Sid`). Must be unique within the bucket policy.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must only contain letters and numbers"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"bucket_url": schema.StringAttribute{
+				Description: "the path style (`stackit_object_storage_bucket.path_style_url`) or host style (`stackit_object_storage_bucket.host_style_url`) URL of the bucket",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"region": schema.StringAttribute{
+				Description: "the region of the bucket (`stackit_object_storage_bucket.region`)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(s3.DefaultRegion),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"access_key": schema.StringAttribute{
+				Description: "access key of a `stackit_object_storage_credential` allowed to manage the bucket policy",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"permission": schema.StringAttribute{
+				Description: "the permission level, one of `read`, `write` or `admin`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PermissionRead, PermissionWrite, PermissionAdmin),
+				},
+			},
+
+			"credentials_group_urns": schema.SetAttribute{
+				Description: "URNs of the credentials groups (`stackit_object_storage_credentials_group.urn`) to grant access to",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,116 @@
+package bucketpolicy
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BucketPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := c.PutBucketPolicy(ctx, plan.Policy.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed setting bucket policy", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(c.Bucket())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BucketPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policy, err := c.GetBucketPolicy(ctx)
+	if err != nil {
+		if s3.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading bucket policy", err.Error())
+		return
+	}
+
+	// keep the configured formatting if the policy didn't change
+	if !jsonEqual(policy, state.Policy.ValueString()) {
+		state.Policy = types.StringValue(policy)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BucketPolicy
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	if !jsonEqual(plan.Policy.ValueString(), state.Policy.ValueString()) {
+		c := client(&resp.Diagnostics, plan)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := c.PutBucketPolicy(ctx, plan.Policy.ValueString()); err != nil {
+			resp.Diagnostics.AddError("failed updating bucket policy", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BucketPolicy
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := c.DeleteBucketPolicy(ctx); err != nil && !s3.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting bucket policy", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func client(diags *diag.Diagnostics, p BucketPolicy) *s3.Client {
+	c, err := s3.New(p.BucketURL.ValueString(), p.Region.ValueString(), p.AccessKey.ValueString(), p.SecretAccessKey.ValueString())
+	if err != nil {
+		diags.AddError("failed to initialize S3 client", err.Error())
+	}
+	return c
+}
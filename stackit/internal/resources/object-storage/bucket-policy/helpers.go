@@ -0,0 +1,18 @@
+package bucketpolicy
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// jsonEqual returns true if both strings are semantically equal JSON documents
+func jsonEqual(a, b string) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal([]byte(a), &av); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &bv); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
@@ -0,0 +1,34 @@
+package bucketpolicy
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+)
+
+func Test_jsonEqual(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{"same", `{"Version":"2012-10-17"}`, `{"Version":"2012-10-17"}`, true},
+		{"formatting", `{"Version":"2012-10-17","Statement":[]}`, "{\n  \"Statement\": [],\n  \"Version\": \"2012-10-17\"\n}", true},
+		{"different", `{"Version":"2012-10-17"}`, `{"Version":"2008-10-17"}`, false},
+		{"statement order", `{"Statement":[{"Sid":"a"},{"Sid":"b"}]}`, `{"Statement":[{"Sid":"b"},{"Sid":"a"}]}`, false},
+		{"invalid", `{"Version":"2012-10-17"}`, `{`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jsonEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("jsonEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package bucketpolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ObjectStorageBucketPolicy(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "s3:GetObject"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_policy.example", "id", name),
+					resource.TestCheckResourceAttrPair("stackit_object_storage_bucket_policy.example", "bucket_url", "stackit_object_storage_bucket.example", "path_style_url"),
+					resource.TestCheckResourceAttrSet("stackit_object_storage_bucket_policy.example", "policy"),
+				),
+			},
+			{
+				Config: config(name, "s3:ListBucket"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket_policy.example", "id", name),
+				),
+			},
+		},
+	})
+}
+
+func config(name, action string) string {
+	return fmt.Sprintf(`
+resource "stackit_object_storage_credential" "example" {
+	project_id = "%s"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+	project_id = stackit_object_storage_credential.example.project_id
+	name       = "%s"
+}
+
+resource "stackit_object_storage_bucket_policy" "example" {
+	bucket_url        = stackit_object_storage_bucket.example.path_style_url
+	region            = stackit_object_storage_bucket.example.region
+	access_key        = stackit_object_storage_credential.example.access_key
+	secret_access_key = stackit_object_storage_credential.example.secret_access_key
+	policy = jsonencode({
+		Version = "2012-10-17"
+		Statement = [
+			{
+				Sid       = "public-read"
+				Effect    = "Allow"
+				Principal = "*"
+				Action    = ["%s"]
+				Resource  = ["arn:aws:s3:::%s/*"]
+			}
+		]
+	})
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		action,
+		name,
+	)
+}
@@ -0,0 +1,85 @@
+package bucketpolicy
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// BucketPolicy is the schema model
+type BucketPolicy struct {
+	ID              types.String `tfsdk:"id"`
+	BucketURL       types.String `tfsdk:"bucket_url"`
+	Region          types.String `tfsdk:"region"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Policy          types.String `tfsdk:"policy"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the S3 policy of an Object Storage bucket.\n\n" +
+			"The policy is set via the S3 API of the bucket using the keys of a `stackit_object_storage_credential`. " +
+			"Since credentials aren't part of the import identifier, the resource doesn't support import.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (the bucket name)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"bucket_url": schema.StringAttribute{
+				Description: "the path style URL of the bucket (`stackit_object_storage_bucket.path_style_url`)",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"region": schema.StringAttribute{
+				Description: "the region of the bucket (`stackit_object_storage_bucket.region`)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(s3.DefaultRegion),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"access_key": schema.StringAttribute{
+				Description: "access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"policy": schema.StringAttribute{
+				Description: "the bucket policy JSON document. Use `jsonencode` to build it. Formatting changes of an applied policy aren't sent to the API.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.StringWith(func(s string) error {
+						var v interface{}
+						return json.Unmarshal([]byte(s), &v)
+					}, "validate policy is a valid JSON document"),
+				},
+			},
+		},
+	}
+}
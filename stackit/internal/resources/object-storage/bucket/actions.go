@@ -32,12 +32,6 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// pre-process config
-	r.preProcessConfig(&resp.Diagnostics, &bucket)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// enable project
 	r.enableProject(ctx, &resp.Diagnostics, &bucket)
 	if resp.Diagnostics.HasError() {
@@ -51,36 +45,37 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	b := res.JSON200
-	// update state
-	diags = resp.State.Set(ctx, Bucket{
-		ID:                     types.StringValue(b.Bucket.Name),
-		Name:                   types.StringValue(b.Bucket.Name),
-		ObjectStorageProjectID: types.StringValue(b.Project),
-		ProjectID:              types.StringValue(b.Project),
-		Region:                 types.StringValue(b.Bucket.Region),
-		HostStyleURL:           types.StringValue(b.Bucket.UrlVirtualHostedStyle),
-		PathStyleURL:           types.StringValue(b.Bucket.UrlPathStyle),
-		Timeouts:               bucket.Timeouts,
-	})
+	state := Bucket{
+		ID:              types.StringValue(b.Bucket.Name),
+		Name:            types.StringValue(b.Bucket.Name),
+		ProjectID:       types.StringValue(b.Project),
+		Region:          types.StringValue(b.Bucket.Region),
+		HostStyleURL:    types.StringValue(b.Bucket.UrlVirtualHostedStyle),
+		PathStyleURL:    types.StringValue(b.Bucket.UrlPathStyle),
+		AccessKey:       bucket.AccessKey,
+		SecretAccessKey: bucket.SecretAccessKey,
+		Timeouts:        bucket.Timeouts,
+	}
 
+	// update state
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-}
 
-func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *Bucket) {
-	projectID := b.ProjectID.ValueString()
-	osProjectID := b.ObjectStorageProjectID.ValueString()
-	if projectID == "" && osProjectID == "" {
-		diags.AddError("project_id or object_storage_project_id must be set", "please note that object_storage_project_id is deprecated and will be removed in a future release")
+	// configure versioning and lifecycle rules
+	state.Versioning = bucket.Versioning
+	state.LifecycleRules = bucket.LifecycleRules
+	applyS3Config(ctx, &resp.Diagnostics, state, nil)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	if projectID == "" {
-		b.ProjectID = b.ObjectStorageProjectID
-	}
-	if osProjectID == "" {
-		b.ObjectStorageProjectID = b.ProjectID
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 }
 
@@ -139,12 +134,6 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	// pre-process config
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	res, err := c.ObjectStorage.Bucket.Get(ctx, state.ProjectID.ValueString(), state.Name.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.Bucket"); agg != nil {
 		resp.Diagnostics.AddError("failed to read bucket", agg.Error())
@@ -157,10 +146,15 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 
 	state.Region = types.StringValue(res.JSON200.Bucket.Region)
-	state.ObjectStorageProjectID = types.StringValue(res.JSON200.Project)
 	state.ProjectID = types.StringValue(res.JSON200.Project)
 	state.HostStyleURL = types.StringValue(res.JSON200.Bucket.UrlVirtualHostedStyle)
 	state.PathStyleURL = types.StringValue(res.JSON200.Bucket.UrlPathStyle)
+
+	readS3Config(ctx, &resp.Diagnostics, &state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -168,8 +162,33 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 }
 
-// Update - lifecycle function - not used for this resource
-func (r Resource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Bucket
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// computed values are taken from the current state
+	plan.ID = state.ID
+	plan.ProjectID = state.ProjectID
+	plan.Region = state.Region
+	plan.HostStyleURL = state.HostStyleURL
+	plan.PathStyleURL = state.PathStyleURL
+
+	// versioning and lifecycle rules are applied if credentials were added
+	if state.AccessKey.IsNull() {
+		applyS3Config(ctx, &resp.Diagnostics, plan, nil)
+	} else {
+		applyS3Config(ctx, &resp.Diagnostics, plan, &state)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 // Delete - lifecycle function
@@ -180,12 +199,6 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
-	// pre-process config
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// handle timeout
 	timeout, diags := state.Timeouts.Delete(ctx, 10*time.Minute)
 	resp.Diagnostics.Append(diags...)
@@ -234,7 +247,6 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	}
 
 	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_storage_project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
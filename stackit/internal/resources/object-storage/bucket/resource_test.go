@@ -47,12 +47,25 @@ func TestAcc_ObjectStorageBucket(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "project_id", common.GetAcceptanceTestsProjectID()),
 				),
 			},
+			// manage versioning and lifecycle rules
+			{
+				Config: configWithS3(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "name", newName),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "versioning.enabled", "true"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "lifecycle_rule.0.expiration_days", "30"),
+					resource.TestCheckResourceAttr("stackit_object_storage_bucket.example", "lifecycle_rule.0.noncurrent_version_expiration_days", "7"),
+				),
+			},
 			// test import
 			{
-				ResourceName:      "stackit_object_storage_bucket.example",
-				ImportStateId:     fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), newName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "stackit_object_storage_bucket.example",
+				ImportStateId:           fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), newName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_access_key", "versioning", "lifecycle_rule"},
 			},
 		},
 	})
@@ -69,3 +82,33 @@ resource "stackit_object_storage_bucket" "example" {
 		name,
 	)
 }
+
+func configWithS3(name string) string {
+	return fmt.Sprintf(`
+resource "stackit_object_storage_credential" "example" {
+	project_id = "%s"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+	project_id        = stackit_object_storage_credential.example.project_id
+	name              = "%s"
+	access_key        = stackit_object_storage_credential.example.access_key
+	secret_access_key = stackit_object_storage_credential.example.secret_access_key
+
+	versioning = {
+		enabled = true
+	}
+
+	lifecycle_rule = [
+		{
+			prefix                             = "logs/"
+			expiration_days                    = 30
+			noncurrent_version_expiration_days = 7
+		}
+	]
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
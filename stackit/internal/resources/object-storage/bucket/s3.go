@@ -0,0 +1,145 @@
+package bucket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// s3Client returns a client for the bucket's S3 API
+// or nil if no credentials were configured
+func s3Client(diags *diag.Diagnostics, b Bucket) *s3.Client {
+	if b.AccessKey.IsNull() || b.SecretAccessKey.IsNull() {
+		return nil
+	}
+	c, err := s3.New(b.PathStyleURL.ValueString(), b.Region.ValueString(), b.AccessKey.ValueString(), b.SecretAccessKey.ValueString())
+	if err != nil {
+		diags.AddError("failed to initialize S3 client", err.Error())
+		return nil
+	}
+	return c
+}
+
+// applyS3Config applies the versioning and lifecycle configuration of the plan
+// state holds the previously applied configuration and is nil during creation
+func applyS3Config(ctx context.Context, diags *diag.Diagnostics, plan Bucket, state *Bucket) {
+	c := s3Client(diags, plan)
+	if c == nil {
+		return
+	}
+
+	if plan.Versioning != nil && (state == nil || state.Versioning == nil || !plan.Versioning.Enabled.Equal(state.Versioning.Enabled)) {
+		status := s3.VersioningSuspended
+		if plan.Versioning.Enabled.ValueBool() {
+			status = s3.VersioningEnabled
+		}
+		// a new bucket is unversioned, there's nothing to suspend
+		if state != nil || status == s3.VersioningEnabled {
+			if err := c.PutBucketVersioning(ctx, status); err != nil {
+				diags.AddError("failed to configure bucket versioning", err.Error())
+				return
+			}
+		}
+	}
+
+	var current []LifecycleRule
+	if state != nil {
+		current = state.LifecycleRules
+	}
+	if lifecycleRulesEqual(plan.LifecycleRules, current) {
+		return
+	}
+	if len(plan.LifecycleRules) == 0 {
+		if err := c.DeleteBucketLifecycle(ctx); err != nil && !s3.IsNotFound(err) {
+			diags.AddError("failed to delete bucket lifecycle configuration", err.Error())
+		}
+		return
+	}
+	if err := c.PutBucketLifecycle(ctx, toS3LifecycleRules(plan.LifecycleRules)); err != nil {
+		diags.AddError("failed to configure bucket lifecycle", err.Error())
+	}
+}
+
+// readS3Config reads the versioning and lifecycle configuration into the state
+func readS3Config(ctx context.Context, diags *diag.Diagnostics, state *Bucket) {
+	c := s3Client(diags, *state)
+	if c == nil {
+		return
+	}
+
+	// versioning is only reported if it's managed
+	if state.Versioning != nil {
+		v, err := c.GetBucketVersioning(ctx)
+		if err != nil {
+			diags.AddError("failed to read bucket versioning", err.Error())
+			return
+		}
+		state.Versioning.Enabled = types.BoolValue(v.Status == s3.VersioningEnabled)
+	}
+
+	l, err := c.GetBucketLifecycle(ctx)
+	if err != nil && !s3.IsNotFound(err) {
+		diags.AddError("failed to read bucket lifecycle configuration", err.Error())
+		return
+	}
+	state.LifecycleRules = fromS3LifecycleRules(l.Rules)
+}
+
+func toS3LifecycleRules(rules []LifecycleRule) []s3.LifecycleRule {
+	res := make([]s3.LifecycleRule, len(rules))
+	for i, r := range rules {
+		res[i] = s3.LifecycleRule{
+			ID:     fmt.Sprintf("rule-%d", i),
+			Filter: &s3.LifecycleFilter{Prefix: r.Prefix.ValueString()},
+			Status: "Enabled",
+		}
+		if !r.ExpirationDays.IsNull() {
+			res[i].Expiration = &s3.Expiration{Days: r.ExpirationDays.ValueInt64()}
+		}
+		if !r.NoncurrentVersionExpirationDays.IsNull() {
+			res[i].NoncurrentVersionExpiration = &s3.NoncurrentVersionExpiration{NoncurrentDays: r.NoncurrentVersionExpirationDays.ValueInt64()}
+		}
+	}
+	return res
+}
+
+func fromS3LifecycleRules(rules []s3.LifecycleRule) []LifecycleRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	res := make([]LifecycleRule, len(rules))
+	for i, r := range rules {
+		res[i] = LifecycleRule{
+			Prefix:                          types.StringNull(),
+			ExpirationDays:                  types.Int64Null(),
+			NoncurrentVersionExpirationDays: types.Int64Null(),
+		}
+		if r.Filter != nil && r.Filter.Prefix != "" {
+			res[i].Prefix = types.StringValue(r.Filter.Prefix)
+		}
+		if r.Expiration != nil {
+			res[i].ExpirationDays = types.Int64Value(r.Expiration.Days)
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			res[i].NoncurrentVersionExpirationDays = types.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)
+		}
+	}
+	return res
+}
+
+func lifecycleRulesEqual(a, b []LifecycleRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Prefix.ValueString() != b[i].Prefix.ValueString() ||
+			!a[i].ExpirationDays.Equal(b[i].ExpirationDays) ||
+			!a[i].NoncurrentVersionExpirationDays.Equal(b[i].NoncurrentVersionExpirationDays) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package bucket
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3/s3test"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestS3Config(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := s3test.NewServer(t, "logs")
+	plan := Bucket{
+		Region:          types.StringValue("eu01"),
+		PathStyleURL:    types.StringValue(srv.BucketURL()),
+		AccessKey:       types.StringValue(s3test.AccessKey),
+		SecretAccessKey: types.StringValue("secret"),
+		Versioning:      &Versioning{Enabled: types.BoolValue(true)},
+		LifecycleRules: []LifecycleRule{
+			{
+				Prefix:                          types.StringValue("logs/"),
+				ExpirationDays:                  types.Int64Value(30),
+				NoncurrentVersionExpirationDays: types.Int64Null(),
+			},
+			{
+				Prefix:                          types.StringNull(),
+				ExpirationDays:                  types.Int64Null(),
+				NoncurrentVersionExpirationDays: types.Int64Value(7),
+			},
+		},
+	}
+
+	// create
+	diags := diag.Diagnostics{}
+	applyS3Config(ctx, &diags, plan, nil)
+	if diags.HasError() {
+		t.Fatalf("applyS3Config() diags = %v", diags)
+	}
+
+	state := plan
+	state.Versioning = &Versioning{Enabled: types.BoolNull()}
+	state.LifecycleRules = nil
+	readS3Config(ctx, &diags, &state)
+	if diags.HasError() {
+		t.Fatalf("readS3Config() diags = %v", diags)
+	}
+	if !reflect.DeepEqual(state, plan) {
+		t.Fatalf("readS3Config() = %+v, want %+v", state, plan)
+	}
+
+	// suspend versioning and remove lifecycle rules
+	update := plan
+	update.Versioning = &Versioning{Enabled: types.BoolValue(false)}
+	update.LifecycleRules = nil
+	applyS3Config(ctx, &diags, update, &plan)
+	if diags.HasError() {
+		t.Fatalf("applyS3Config() diags = %v", diags)
+	}
+	readS3Config(ctx, &diags, &state)
+	if diags.HasError() {
+		t.Fatalf("readS3Config() diags = %v", diags)
+	}
+	if !reflect.DeepEqual(state, update) {
+		t.Fatalf("readS3Config() = %+v, want %+v", state, update)
+	}
+	if _, ok := srv.Subresource("lifecycle"); ok {
+		t.Error("expected lifecycle configuration to be deleted")
+	}
+
+	// without credentials nothing is read
+	state.AccessKey = types.StringNull()
+	state.Versioning = nil
+	readS3Config(ctx, &diags, &state)
+	if diags.HasError() || state.Versioning != nil {
+		t.Fatalf("readS3Config() = %+v, diags = %v", state, diags)
+	}
+}
@@ -7,6 +7,11 @@ import (
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -17,19 +22,35 @@ import (
 
 // Bucket is the schema model
 type Bucket struct {
-	ID                     types.String   `tfsdk:"id"`
-	Name                   types.String   `tfsdk:"name"`
-	ProjectID              types.String   `tfsdk:"project_id"`
-	ObjectStorageProjectID types.String   `tfsdk:"object_storage_project_id"`
-	Region                 types.String   `tfsdk:"region"`
-	HostStyleURL           types.String   `tfsdk:"host_style_url"`
-	PathStyleURL           types.String   `tfsdk:"path_style_url"`
-	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	ID              types.String    `tfsdk:"id"`
+	Name            types.String    `tfsdk:"name"`
+	ProjectID       types.String    `tfsdk:"project_id"`
+	Region          types.String    `tfsdk:"region"`
+	HostStyleURL    types.String    `tfsdk:"host_style_url"`
+	PathStyleURL    types.String    `tfsdk:"path_style_url"`
+	AccessKey       types.String    `tfsdk:"access_key"`
+	SecretAccessKey types.String    `tfsdk:"secret_access_key"`
+	Versioning      *Versioning     `tfsdk:"versioning"`
+	LifecycleRules  []LifecycleRule `tfsdk:"lifecycle_rule"`
+	Timeouts        timeouts.Value  `tfsdk:"timeouts"`
+}
+
+// Versioning is the bucket versioning configuration
+type Versioning struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+// LifecycleRule is a bucket lifecycle rule
+type LifecycleRule struct {
+	Prefix                          types.String `tfsdk:"prefix"`
+	ExpirationDays                  types.Int64  `tfsdk:"expiration_days"`
+	NoncurrentVersionExpirationDays types.Int64  `tfsdk:"noncurrent_version_expiration_days"`
 }
 
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages Object Storage buckets\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
@@ -37,6 +58,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"id": schema.StringAttribute{
 				Description: "Specifies the resource ID",
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			"name": schema.StringAttribute{
@@ -47,23 +71,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 
-			"object_storage_project_id": schema.StringAttribute{
-				DeprecationMessage: "This attribute is deprecated and will be removed in a future version of the provider. Please use `project_id` instead.",
-				Description:        "The ID returned from `stackit_object_storage_project`",
-				Optional:           true,
-				Computed:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-
 			"project_id": schema.StringAttribute{
 				Description: "The project UUID.",
-				Optional:    true,
-				Computed:    true,
+				Required:    true,
 				Validators: []validator.String{
 					validate.ProjectID(),
 				},
@@ -77,6 +87,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			"host_style_url": schema.StringAttribute{
@@ -84,6 +97,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			"path_style_url": schema.StringAttribute{
@@ -91,6 +107,76 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"access_key": schema.StringAttribute{
+				Description: "access key of a `stackit_object_storage_credential`. Required to manage `versioning` and `lifecycle_rule` through the S3 API.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_access_key")),
+				},
+			},
+
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key of a `stackit_object_storage_credential`",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+			},
+
+			"versioning": schema.SingleNestedAttribute{
+				Description: "bucket versioning configuration. Once enabled, versioning can only be suspended by setting `enabled` to `false`. Removing the attribute leaves the current configuration unchanged.",
+				Optional:    true,
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "keep multiple versions of an object in the bucket",
+						Required:    true,
+					},
+				},
+			},
+
+			"lifecycle_rule": schema.ListNestedAttribute{
+				Description: "lifecycle rules expiring objects of the bucket. Removing all rules deletes the bucket's lifecycle configuration.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.AlsoRequires(path.MatchRoot("access_key")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"prefix": schema.StringAttribute{
+							Description: "object key prefix the rule applies to. If not set, the rule applies to all objects.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"expiration_days": schema.Int64Attribute{
+							Description: "number of days after which current object versions expire",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("noncurrent_version_expiration_days")),
+							},
+						},
+						"noncurrent_version_expiration_days": schema.Int64Attribute{
+							Description: "number of days after which noncurrent object versions expire",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+					},
+				},
 			},
 
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
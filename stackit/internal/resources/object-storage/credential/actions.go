@@ -6,6 +6,7 @@ import (
 	"time"
 
 	accesskey "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1/access-key"
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,57 +22,49 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &data)
+	// handle project init
+	r.enableProject(ctx, &resp.Diagnostics, &data)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// handle project init
-	r.enableProject(ctx, &resp.Diagnostics, &data)
-	if resp.Diagnostics.HasError() {
+	expires, err := expiresAt(data)
+	if err != nil {
+		resp.Diagnostics.AddError("couldn't determine expiry", err.Error())
 		return
 	}
 
 	// handle creation
-	res := r.createAccessKey(ctx, resp, data)
+	res := r.createAccessKey(ctx, &resp.Diagnostics, data, expires)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	k := res.JSON201
+	state := Credential{
+		ID:                 types.StringValue(k.KeyID),
+		ProjectID:          types.StringValue(k.Project),
+		CredentialsGroupID: types.StringValue(data.CredentialsGroupID.ValueString()),
+		Expiry:             types.StringValue(k.Expires),
+		DisplayName:        types.StringValue(k.DisplayName),
+		AccessKey:          types.StringValue(k.AccessKey),
+		SecretAccessKey:    types.StringValue(k.SecretAccessKey),
+		RotationDays:       data.RotationDays,
+		RotateBefore:       data.RotateBefore,
+		Overlap:            data.Overlap,
+		RotatedAt:          types.StringNull(),
+		Previous:           types.ObjectNull(keyTypes),
+	}
+	state.Current = currentKey(state).object()
+
 	// update state
-	diags = resp.State.Set(ctx, Credential{
-		ID:                     types.StringValue(k.KeyID),
-		ObjectStorageProjectID: types.StringValue(k.Project),
-		ProjectID:              types.StringValue(k.Project),
-		CredentialsGroupID:     types.StringValue(data.CredentialsGroupID.ValueString()),
-		Expiry:                 types.StringValue(k.Expires),
-		DisplayName:            types.StringValue(k.DisplayName),
-		AccessKey:              types.StringValue(k.AccessKey),
-		SecretAccessKey:        types.StringValue(k.SecretAccessKey),
-	})
+	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
-func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *Credential) {
-	projectID := b.ProjectID.ValueString()
-	osProjectID := b.ObjectStorageProjectID.ValueString()
-	if projectID == "" && osProjectID == "" {
-		diags.AddError("project_id or object_storage_project_id must be set", "please note that object_storage_project_id is deprecated and will be removed in a future release")
-		return
-	}
-	if projectID == "" {
-		b.ProjectID = b.ObjectStorageProjectID
-	}
-	if osProjectID == "" {
-		b.ObjectStorageProjectID = b.ProjectID
-	}
-}
-
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b *Credential) {
 	projectID := b.ProjectID.ValueString()
 	c := r.client.ObjectStorage.Project
@@ -91,16 +84,31 @@ func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b
 	}
 }
 
-func (r Resource) createAccessKey(ctx context.Context, resp *resource.CreateResponse, key Credential) *accesskey.CreateResponse {
-	c := r.client
-	body := accesskey.CreateJSONRequestBody{}
+// expiresAt returns the expiry of a new key
+// either the configured expiry or the rotation lifetime
+func expiresAt(key Credential) (*time.Time, error) {
+	if !key.RotationDays.IsNull() {
+		lifetime, _, _, err := rotationSettings(key)
+		if err != nil {
+			return nil, err
+		}
+		t := now().UTC().Add(lifetime)
+		return &t, nil
+	}
 	if !key.Expiry.IsNull() && !key.Expiry.IsUnknown() {
 		t, err := time.Parse("2006-01-02T15:04:05.999Z", key.Expiry.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("couldn't parse expiry", err.Error())
-			return nil
+			return nil, err
 		}
-		body.Expires = &t
+		return &t, nil
+	}
+	return nil, nil
+}
+
+func (r Resource) createAccessKey(ctx context.Context, diags *diag.Diagnostics, key Credential, expires *time.Time) *accesskey.CreateResponse {
+	c := r.client
+	body := accesskey.CreateJSONRequestBody{
+		Expires: expires,
 	}
 	cg := key.CredentialsGroupID.ValueString()
 	params := &accesskey.CreateParams{
@@ -109,9 +117,9 @@ func (r Resource) createAccessKey(ctx context.Context, resp *resource.CreateResp
 	if cg == "" {
 		params.CredentialsGroup = nil
 	}
-	res, err := c.ObjectStorage.AccessKey.Create(ctx, key.ObjectStorageProjectID.ValueString(), params, body)
-	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
-		resp.Diagnostics.AddError("failed to create credential", agg.Error())
+	res, err := c.ObjectStorage.AccessKey.Create(ctx, key.ProjectID.ValueString(), params, body)
+	if agg := common.Validate(diags, res, err, "JSON201"); agg != nil {
+		diags.AddError("failed to create credential", agg.Error())
 		return res
 	}
 
@@ -129,12 +137,6 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	cg := state.CredentialsGroupID.ValueString()
 	params := &accesskey.GetParams{
 		CredentialsGroup: &cg,
@@ -149,46 +151,104 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	found := false
+	expiries := map[string]string{}
 	for _, k := range res.JSON200.AccessKeys {
-		if k.KeyID != state.ID.ValueString() {
-			continue
-		}
-		found = true
-		state.DisplayName = types.StringValue(k.DisplayName)
-		state.Expiry = types.StringValue(k.Expires)
-		diags = resp.State.Set(ctx, &state)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		expiries[k.KeyID] = k.Expires
+		if k.KeyID == state.ID.ValueString() {
+			state.DisplayName = types.StringValue(k.DisplayName)
 		}
-		break
 	}
 
-	if !found {
+	expiry, found := expiries[state.ID.ValueString()]
+	t := now()
+	// expired keys are treated as drift, unless they are replaced by a rotation
+	if !found || (state.RotationDays.IsNull() && expired(expiry, t)) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
-}
+	state.Expiry = types.StringValue(expiry)
+	state.Current = currentKey(state).object()
 
-// Update - lifecycle function - not used for this resource
-func (r Resource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+	if prev := keyOf(ctx, &resp.Diagnostics, state.Previous); prev != nil {
+		e, ok := expiries[prev.ID.ValueString()]
+		if !ok || expired(e, t) {
+			state.Previous = types.ObjectNull(keyTypes)
+		}
+	}
+	if state.Previous.IsUnknown() {
+		state.Previous = types.ObjectNull(keyTypes)
+	}
+	if state.RotatedAt.IsUnknown() {
+		state.RotatedAt = types.StringNull()
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
-// Delete - lifecycle function
-func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var state Credential
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Credential
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &state)
+	switch {
+	case plan.AccessKey.IsUnknown():
+		r.rotate(ctx, &resp.Diagnostics, &plan, state)
+	case plan.Previous.IsUnknown():
+		if prev := keyOf(ctx, &resp.Diagnostics, state.Previous); prev != nil {
+			r.deleteAccessKey(ctx, &resp.Diagnostics, state, prev.ID.ValueString(), true)
+		}
+		plan.Previous = types.ObjectNull(keyTypes)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// rotate creates a replacement key, the current key becomes the previous one
+// and an existing previous key is deleted
+func (r Resource) rotate(ctx context.Context, diags *diag.Diagnostics, plan *Credential, state Credential) {
+	expires, err := expiresAt(*plan)
+	if err != nil {
+		diags.AddError("couldn't determine expiry", err.Error())
+		return
+	}
+
+	res := r.createAccessKey(ctx, diags, state, expires)
+	if diags.HasError() {
+		return
+	}
+
+	if prev := keyOf(ctx, diags, state.Previous); prev != nil {
+		r.deleteAccessKey(ctx, diags, state, prev.ID.ValueString(), true)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	k := res.JSON201
+	plan.ID = types.StringValue(k.KeyID)
+	plan.Expiry = types.StringValue(k.Expires)
+	plan.DisplayName = types.StringValue(k.DisplayName)
+	plan.AccessKey = types.StringValue(k.AccessKey)
+	plan.SecretAccessKey = types.StringValue(k.SecretAccessKey)
+	plan.RotatedAt = types.StringValue(now().UTC().Format(time.RFC3339))
+	plan.Current = currentKey(*plan).object()
+	plan.Previous = currentKey(state).object()
+}
+
+// deleteAccessKey deletes a single key
+// if ignoreNotFound is set, keys that were already removed don't cause an error
+func (r Resource) deleteAccessKey(ctx context.Context, diags *diag.Diagnostics, state Credential, keyID string, ignoreNotFound bool) {
 	cg := state.CredentialsGroupID.ValueString()
 	params := &accesskey.DeleteParams{
 		CredentialsGroup: &cg,
@@ -197,10 +257,29 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		params.CredentialsGroup = nil
 	}
 
-	c := r.client
-	res, err := c.ObjectStorage.AccessKey.Delete(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), params)
-	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
-		resp.Diagnostics.AddError("failed to delete credential", agg.Error())
+	res, err := r.client.ObjectStorage.AccessKey.Delete(ctx, state.ProjectID.ValueString(), keyID, params)
+	if agg := common.Validate(&diag.Diagnostics{}, res, err); agg != nil {
+		if ignoreNotFound && validate.StatusEquals(res, http.StatusNotFound) {
+			return
+		}
+		diags.AddError("failed to delete credential", agg.Error())
+	}
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Credential
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the previous key is deleted as well
+	if prev := keyOf(ctx, &resp.Diagnostics, state.Previous); prev != nil {
+		r.deleteAccessKey(ctx, &resp.Diagnostics, state, prev.ID.ValueString(), true)
+	}
+	r.deleteAccessKey(ctx, &resp.Diagnostics, state, state.ID.ValueString(), false)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
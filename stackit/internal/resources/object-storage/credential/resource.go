@@ -7,6 +7,7 @@ import (
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/baseurl"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	objectstorage "github.com/SchwarzIT/community-stackit-go-client/pkg/services/object-storage/v1.0.1"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -24,6 +25,8 @@ type Resource struct {
 }
 
 var _ = resource.Resource(&Resource{})
+var _ = resource.ResourceWithUpgradeState(&Resource{})
+var _ = resource.ResourceWithModifyPlan(&Resource{})
 
 // Metadata returns data resource metadata
 func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
@@ -50,3 +53,14 @@ func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest,
 
 	r.client = c
 }
+
+// UpgradeState upgrades the state of previous schema versions
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	res := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, res)
+
+	return map[int64]resource.StateUpgrader{
+		// version 0 had the deprecated `object_storage_project_id`
+		0: common.MoveAttributeUpgrader(res.Schema, "object_storage_project_id", "project_id"),
+	}
+}
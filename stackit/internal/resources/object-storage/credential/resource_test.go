@@ -37,6 +37,16 @@ func TestAcc_ObjectStorageCredential(t *testing.T) {
 					resource.TestCheckResourceAttrSet("stackit_object_storage_credential.example", "secret_access_key"),
 				),
 			},
+			// enable rotation
+			{
+				Config: configWithRotation(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_credential.example", "rotation_days", "30"),
+					resource.TestCheckResourceAttrSet("stackit_object_storage_credential.example", "current.id"),
+					resource.TestCheckResourceAttrPair("stackit_object_storage_credential.example", "current.access_key", "stackit_object_storage_credential.example", "access_key"),
+					resource.TestCheckNoResourceAttr("stackit_object_storage_credential.example", "previous"),
+				),
+			},
 			{
 				Config: configWithGroup(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -61,6 +71,20 @@ func config() string {
 	)
 }
 
+func configWithRotation() string {
+	return fmt.Sprintf(`
+
+	resource "stackit_object_storage_credential" "example" {
+		project_id    = "%s"
+		rotation_days = 30
+		rotate_before = "72h"
+		overlap       = "24h"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
+
 func configWithGroup(groupName string) string {
 	return fmt.Sprintf(`
 
@@ -0,0 +1,171 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const defaultRotateBefore = 7 * 24 * time.Hour
+
+// now is replaced in tests
+var now = time.Now
+
+// key is a single access key of the credential
+type key struct {
+	ID              types.String `tfsdk:"id"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	Expiry          types.String `tfsdk:"expiry"`
+}
+
+var keyTypes = map[string]attr.Type{
+	"id":                types.StringType,
+	"access_key":        types.StringType,
+	"secret_access_key": types.StringType,
+	"expiry":            types.StringType,
+}
+
+func (k key) object() types.Object {
+	return types.ObjectValueMust(keyTypes, map[string]attr.Value{
+		"id":                k.ID,
+		"access_key":        k.AccessKey,
+		"secret_access_key": k.SecretAccessKey,
+		"expiry":            k.Expiry,
+	})
+}
+
+// keyOf returns the key of the object or nil if the object is null or unknown
+func keyOf(ctx context.Context, diags *diag.Diagnostics, o types.Object) *key {
+	if o.IsNull() || o.IsUnknown() {
+		return nil
+	}
+	k := key{}
+	diags.Append(o.As(ctx, &k, basetypes.ObjectAsOptions{})...)
+	return &k
+}
+
+// currentKey returns the current key based on the top level attributes
+func currentKey(c Credential) key {
+	return key{
+		ID:              c.ID,
+		AccessKey:       c.AccessKey,
+		SecretAccessKey: c.SecretAccessKey,
+		Expiry:          c.Expiry,
+	}
+}
+
+func validateDuration(s string) error {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	if d <= 0 {
+		return fmt.Errorf("duration %s must be positive", s)
+	}
+	return nil
+}
+
+// rotationSettings returns the rotation lifetime, rotate_before and overlap durations
+// overlap is nil if the previous key is kept until it expires
+func rotationSettings(c Credential) (lifetime, rotateBefore time.Duration, overlap *time.Duration, err error) {
+	lifetime = time.Duration(c.RotationDays.ValueInt64()) * 24 * time.Hour
+	rotateBefore = defaultRotateBefore
+	if !c.RotateBefore.IsNull() {
+		if rotateBefore, err = time.ParseDuration(c.RotateBefore.ValueString()); err != nil {
+			return
+		}
+	}
+	if rotateBefore >= lifetime {
+		err = fmt.Errorf("rotate_before (%s) must be shorter than rotation_days (%s)", rotateBefore, lifetime)
+		return
+	}
+	if !c.Overlap.IsNull() {
+		var o time.Duration
+		if o, err = time.ParseDuration(c.Overlap.ValueString()); err != nil {
+			return
+		}
+		overlap = &o
+	}
+	return
+}
+
+// parseTime parses API and state timestamps, an empty string returns nil
+func parseTime(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// expired returns true if the expiry has passed
+func expired(expiry string, t time.Time) bool {
+	e := parseTime(expiry)
+	return e != nil && !t.Before(*e)
+}
+
+// rotationDue returns true if a replacement of the key with the given expiry has to be created
+// keys without an expiry are replaced right away
+func rotationDue(expiry string, rotateBefore time.Duration, t time.Time) bool {
+	e := parseTime(expiry)
+	return e == nil || !t.Before(e.Add(-rotateBefore))
+}
+
+// previousDue returns true if the previous key has to be deleted
+func previousDue(expiry, rotatedAt string, overlap *time.Duration, t time.Time) bool {
+	if expired(expiry, t) {
+		return true
+	}
+	r := parseTime(rotatedAt)
+	return overlap != nil && r != nil && !t.Before(r.Add(*overlap))
+}
+
+// ModifyPlan plans key rotations and the removal of previous keys
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// creation or deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state Credential
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotationDays.IsNull() || plan.RotationDays.IsUnknown() || plan.RotateBefore.IsUnknown() || plan.Overlap.IsUnknown() {
+		return
+	}
+
+	_, rotateBefore, overlap, err := rotationSettings(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before"), "invalid rotation settings", err.Error())
+		return
+	}
+
+	t := now()
+	if rotationDue(state.Expiry.ValueString(), rotateBefore, t) {
+		for _, p := range []string{"id", "expiry", "display_name", "access_key", "secret_access_key", "rotated_at"} {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(p), types.StringUnknown())...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("current"), types.ObjectUnknown(keyTypes))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous"), types.ObjectUnknown(keyTypes))...)
+		return
+	}
+
+	if prev := keyOf(ctx, &resp.Diagnostics, state.Previous); prev != nil && previousDue(prev.Expiry.ValueString(), state.RotatedAt.ValueString(), overlap, t) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous"), types.ObjectUnknown(keyTypes))...)
+	}
+}
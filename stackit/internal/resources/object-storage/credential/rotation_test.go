@@ -0,0 +1,105 @@
+package credential
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_rotationDue(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	ts := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		expiry string
+		want   bool
+	}{
+		{"no expiry", "", true},
+		{"far from expiry", "2023-07-01T00:00:00.000Z", false},
+		{"within rotate_before", "2023-06-05T00:00:00.000Z", true},
+		{"exactly rotate_before", "2023-06-08T00:00:00Z", true},
+		{"expired", "2023-05-01T00:00:00Z", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rotationDue(tt.expiry, defaultRotateBefore, ts); got != tt.want {
+				t.Errorf("rotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_previousDue(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	ts := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name      string
+		expiry    string
+		rotatedAt string
+		overlap   *time.Duration
+		want      bool
+	}{
+		{"kept until expiry", "2023-06-05T00:00:00Z", "2023-05-01T00:00:00Z", nil, false},
+		{"expired", "2023-05-31T00:00:00Z", "2023-05-01T00:00:00Z", nil, true},
+		{"within overlap", "2023-06-05T00:00:00Z", "2023-05-31T12:00:00Z", &day, false},
+		{"overlap elapsed", "2023-06-05T00:00:00Z", "2023-05-30T00:00:00Z", &day, true},
+		{"unknown rotation time", "2023-06-05T00:00:00Z", "", &day, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := previousDue(tt.expiry, tt.rotatedAt, tt.overlap, ts); got != tt.want {
+				t.Errorf("previousDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_expiresAt(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+	ts := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return ts }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		name    string
+		c       Credential
+		want    *time.Time
+		wantErr bool
+	}{
+		{"no expiry", Credential{RotationDays: types.Int64Null(), Expiry: types.StringUnknown()}, nil, false},
+		{"fixed expiry", Credential{RotationDays: types.Int64Null(), Expiry: types.StringValue("2023-07-01T00:00:00.000Z")}, timePtr(time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)), false},
+		{"rotation", Credential{RotationDays: types.Int64Value(30), RotateBefore: types.StringNull(), Overlap: types.StringNull()}, timePtr(ts.Add(30 * 24 * time.Hour)), false},
+		{"rotate_before too long", Credential{RotationDays: types.Int64Value(5), RotateBefore: types.StringNull(), Overlap: types.StringNull()}, nil, true},
+		{"custom rotate_before", Credential{RotationDays: types.Int64Value(5), RotateBefore: types.StringValue("48h"), Overlap: types.StringValue("24h")}, timePtr(ts.Add(5 * 24 * time.Hour)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expiresAt(tt.c)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expiresAt() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("expiresAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -7,8 +7,12 @@ import (
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -17,20 +21,59 @@ import (
 
 // Credential is the schema model
 type Credential struct {
-	ID                     types.String `tfsdk:"id"`
-	ProjectID              types.String `tfsdk:"project_id"`
-	ObjectStorageProjectID types.String `tfsdk:"object_storage_project_id"`
-	CredentialsGroupID     types.String `tfsdk:"credentials_group_id"`
-	Expiry                 types.String `tfsdk:"expiry"`
-	DisplayName            types.String `tfsdk:"display_name"`
-	AccessKey              types.String `tfsdk:"access_key"`
-	SecretAccessKey        types.String `tfsdk:"secret_access_key"`
+	ID                 types.String `tfsdk:"id"`
+	ProjectID          types.String `tfsdk:"project_id"`
+	CredentialsGroupID types.String `tfsdk:"credentials_group_id"`
+	Expiry             types.String `tfsdk:"expiry"`
+	DisplayName        types.String `tfsdk:"display_name"`
+	AccessKey          types.String `tfsdk:"access_key"`
+	SecretAccessKey    types.String `tfsdk:"secret_access_key"`
+	RotationDays       types.Int64  `tfsdk:"rotation_days"`
+	RotateBefore       types.String `tfsdk:"rotate_before"`
+	Overlap            types.String `tfsdk:"overlap"`
+	RotatedAt          types.String `tfsdk:"rotated_at"`
+	Current            types.Object `tfsdk:"current"`
+	Previous           types.Object `tfsdk:"previous"`
+}
+
+// keySchema returns the attributes of the current & previous keys
+func keySchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the credential ID",
+				Computed:    true,
+			},
+			"access_key": schema.StringAttribute{
+				Description: "access key (sensitive)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key (sensitive)",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiry": schema.StringAttribute{
+				Description: "expiry of the key",
+				Computed:    true,
+			},
+		},
+	}
 }
 
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: fmt.Sprintf("Manages Object Storage credentials\n%s",
+		Version: 1,
+		MarkdownDescription: fmt.Sprintf("Manages Object Storage credentials\n\n"+
+			"When `rotation_days` is set, keys are rotated automatically: once the current key is within `rotate_before` of its expiry, "+
+			"the next apply creates a replacement and keeps the old key as `previous` for the `overlap` period, so consumers can roll over without downtime.\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
 		Attributes: map[string]schema.Attribute{
@@ -44,23 +87,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				},
 			},
 
-			"object_storage_project_id": schema.StringAttribute{
-				DeprecationMessage: "This attribute is deprecated and will be removed in a future version of the provider. Please use `project_id` instead.",
-				Description:        "The ID returned from `stackit_object_storage_project`",
-				Optional:           true,
-				Computed:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-
 			"project_id": schema.StringAttribute{
 				Description: "The project UUID.",
-				Optional:    true,
-				Computed:    true,
+				Required:    true,
 				Validators: []validator.String{
 					validate.ProjectID(),
 				},
@@ -74,18 +103,20 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 
 			"expiry": schema.StringAttribute{
-				Description: "specifies if the credential should expire. changing this field will recreate the credential.",
+				Description: "specifies if the credential should expire. changing this field will recreate the credential. Expired credentials are recreated.",
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
 					validate.StringWith(clientValidate.ISO8601, "validate expiry is ISO-8601 compatible"),
 				},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -95,6 +126,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			"access_key": schema.StringAttribute{
@@ -103,6 +137,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Required:    false,
 				Optional:    false,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 
 			"secret_access_key": schema.StringAttribute{
@@ -111,7 +148,49 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Required:    false,
 				Optional:    false,
 				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"rotation_days": schema.Int64Attribute{
+				Description: "enables key rotation. Each key expires after the given number of days.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("expiry")),
+				},
 			},
+
+			"rotate_before": schema.StringAttribute{
+				Description: fmt.Sprintf("duration before the expiry of the current key at which a replacement is created, e.g. `72h`. Must be shorter than `rotation_days`. Defaults to `%s`.", defaultRotateBefore),
+				Optional:    true,
+				Validators: []validator.String{
+					validate.StringWith(validateDuration, "validate rotate_before is a duration"),
+					stringvalidator.AlsoRequires(path.MatchRoot("rotation_days")),
+				},
+			},
+
+			"overlap": schema.StringAttribute{
+				Description: "duration for which the previous key is kept after a rotation, e.g. `24h`. If not set, the previous key is kept until it expires.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.StringWith(validateDuration, "validate overlap is a duration"),
+					stringvalidator.AlsoRequires(path.MatchRoot("rotation_days")),
+				},
+			},
+
+			"rotated_at": schema.StringAttribute{
+				Description: "time of the last key rotation (RFC3339)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"current": keySchema("the current key. `access_key` and `secret_access_key` refer to this key."),
+
+			"previous": keySchema("the previous key, which is kept valid during the overlap after a rotation"),
 		},
 	}
 }
@@ -24,12 +24,6 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &data)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// handle project init
 	r.enableProject(ctx, &resp.Diagnostics, &data)
 	if resp.Diagnostics.HasError() {
@@ -55,13 +49,13 @@ func (r Resource) createCredentialGroup(ctx context.Context, diags *diag.Diagnos
 	body := credentialsgroup.CreateJSONRequestBody{
 		DisplayName: data.Name.ValueString(),
 	}
-	cres, err := c.ObjectStorage.CredentialsGroup.Create(ctx, data.ObjectStorageProjectID.ValueString(), body)
+	cres, err := c.ObjectStorage.CredentialsGroup.Create(ctx, data.ProjectID.ValueString(), body)
 	if agg := common.Validate(diags, cres, err); agg != nil {
 		return diag.NewErrorDiagnostic("failed to create credential group", agg.Error())
 
 	}
 
-	res, err := c.ObjectStorage.CredentialsGroup.Get(ctx, data.ObjectStorageProjectID.ValueString())
+	res, err := c.ObjectStorage.CredentialsGroup.Get(ctx, data.ProjectID.ValueString())
 	if agg := common.Validate(diags, res, err, "JSON200.CredentialsGroups"); agg != nil {
 		return diag.NewErrorDiagnostic("failed to list credential groups", agg.Error())
 	}
@@ -76,21 +70,6 @@ func (r Resource) createCredentialGroup(ctx context.Context, diags *diag.Diagnos
 	return nil
 }
 
-func (r Resource) preProcessConfig(diags *diag.Diagnostics, b *CredentialsGroup) {
-	projectID := b.ProjectID.ValueString()
-	osProjectID := b.ObjectStorageProjectID.ValueString()
-	if projectID == "" && osProjectID == "" {
-		diags.AddError("project_id or object_storage_project_id must be set", "please note that object_storage_project_id is deprecated and will be removed in a future release")
-		return
-	}
-	if projectID == "" {
-		b.ProjectID = b.ObjectStorageProjectID
-	}
-	if osProjectID == "" {
-		b.ObjectStorageProjectID = b.ProjectID
-	}
-}
-
 func (r Resource) enableProject(ctx context.Context, diags *diag.Diagnostics, b *CredentialsGroup) {
 	projectID := b.ProjectID.ValueString()
 	c := r.client.ObjectStorage.Project
@@ -120,13 +99,7 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	res, err := c.ObjectStorage.CredentialsGroup.Get(ctx, state.ObjectStorageProjectID.ValueString())
+	res, err := c.ObjectStorage.CredentialsGroup.Get(ctx, state.ProjectID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200.CredentialsGroups"); agg != nil {
 		resp.Diagnostics.AddError("failed to read credential groups", agg.Error())
 		return
@@ -166,14 +139,8 @@ func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *
 		return
 	}
 
-	// pre process plan
-	r.preProcessConfig(&resp.Diagnostics, &state)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	c := r.client.ObjectStorage.CredentialsGroup
-	res, err := c.Delete(ctx, state.ObjectStorageProjectID.ValueString(), state.ID.ValueString())
+	res, err := c.Delete(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
 	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
 		resp.Diagnostics.AddError("failed to delete credential groups", agg.Error())
 		return
@@ -204,7 +171,6 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	}
 
 	// set main attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("object_storage_project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[1])...)
 }
@@ -16,16 +16,16 @@ import (
 
 // CredentialsGroup is the schema model
 type CredentialsGroup struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	ProjectID              types.String `tfsdk:"project_id"`
-	ObjectStorageProjectID types.String `tfsdk:"object_storage_project_id"`
-	URN                    types.String `tfsdk:"urn"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ProjectID types.String `tfsdk:"project_id"`
+	URN       types.String `tfsdk:"urn"`
 }
 
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages Object Storage credential groups\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
@@ -37,23 +37,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 			},
 
-			"object_storage_project_id": schema.StringAttribute{
-				DeprecationMessage: "This attribute is deprecated and will be removed in a future version of the provider. Please use `project_id` instead.",
-				Description:        "The ID returned from `stackit_object_storage_project`",
-				Optional:           true,
-				Computed:           true,
-				Validators: []validator.String{
-					validate.ProjectID(),
-				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-
 			"project_id": schema.StringAttribute{
 				Description: "The project UUID.",
-				Optional:    true,
-				Computed:    true,
+				Required:    true,
 				Validators: []validator.String{
 					validate.ProjectID(),
 				},
@@ -0,0 +1,189 @@
+package object
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ModifyPlan plans the ETag of the content to upload
+// so that changes of a source file trigger an update
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Object
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// content is only known after apply
+	if plan.Content.IsUnknown() || plan.Source.IsUnknown() {
+		return
+	}
+
+	b, err := body(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "failed reading source", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("etag"), etag(b))...)
+}
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Object
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upload(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// upload puts the object and updates the computed attributes
+func upload(ctx context.Context, diags *diag.Diagnostics, plan *Object) {
+	c := client(diags, *plan)
+	if diags.HasError() {
+		return
+	}
+
+	b, err := body(*plan)
+	if err != nil {
+		diags.AddError("failed reading object content", err.Error())
+		return
+	}
+
+	contentType := ""
+	if !plan.ContentType.IsUnknown() {
+		contentType = plan.ContentType.ValueString()
+	}
+	if _, err := c.PutObject(ctx, plan.Key.ValueString(), b, contentType, plan.Metadata); err != nil {
+		diags.AddError("failed uploading object", err.Error())
+		return
+	}
+
+	o, err := c.HeadObject(ctx, plan.Key.ValueString())
+	if err != nil {
+		diags.AddError("failed reading uploaded object", err.Error())
+		return
+	}
+
+	plan.ID = plan.Key
+	plan.ContentType = types.StringValue(o.ContentType)
+	// the planned ETag is kept, as it may differ for server side encrypted objects
+	plan.ETag = types.StringValue(etag(b))
+	plan.RemoteETag = types.StringValue(o.ETag)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Object
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	o, err := c.HeadObject(ctx, state.Key.ValueString())
+	if err != nil {
+		if s3.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading object", err.Error())
+		return
+	}
+
+	state.ID = state.Key
+	state.ContentType = types.StringValue(o.ContentType)
+	refreshETag(&state, o.ETag)
+	state.Metadata = o.Metadata
+	if len(state.Metadata) == 0 {
+		state.Metadata = nil
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Object
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the credentials changed
+	if plan.ETag.Equal(state.ETag) && plan.ContentType.Equal(state.ContentType) && metadataEqual(plan.Metadata, state.Metadata) {
+		plan.ID = state.ID
+		plan.RemoteETag = state.RemoteETag
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	upload(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Object
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(&resp.Diagnostics, state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := c.DeleteObject(ctx, state.Key.ValueString()); err != nil && !s3.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting object", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func client(diags *diag.Diagnostics, o Object) *s3.Client {
+	c, err := s3.New(o.BucketURL.ValueString(), o.Region.ValueString(), o.AccessKey.ValueString(), o.SecretAccessKey.ValueString())
+	if err != nil {
+		diags.AddError("failed to initialize S3 client", err.Error())
+	}
+	return c
+}
+
+func metadataEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,41 @@
+package object
+
+import (
+	"crypto/md5" //nolint:gosec // ETag of the S3 API
+	"encoding/hex"
+	"errors"
+	"os"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var metadataKeyRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// body returns the content to upload
+// either the literal content or the content of the source file
+func body(o Object) ([]byte, error) {
+	if !o.Content.IsNull() {
+		return []byte(o.Content.ValueString()), nil
+	}
+	if o.Source.IsNull() {
+		return nil, errors.New("either source or content must be set")
+	}
+	return os.ReadFile(o.Source.ValueString())
+}
+
+// refreshETag updates the state with the ETag returned by the S3 API
+// the state ETag is only replaced if the object changed since the last upload,
+// which keeps it stable for server side encrypted objects
+func refreshETag(state *Object, remote string) {
+	if !state.RemoteETag.IsNull() && !state.RemoteETag.IsUnknown() && state.RemoteETag.ValueString() != remote {
+		state.ETag = types.StringValue(remote)
+	}
+	state.RemoteETag = types.StringValue(remote)
+}
+
+// etag returns the ETag of single part uploads: the hex encoded MD5 checksum
+func etag(b []byte) string {
+	sum := md5.Sum(b) //nolint:gosec // ETag of the S3 API
+	return hex.EncodeToString(sum[:])
+}
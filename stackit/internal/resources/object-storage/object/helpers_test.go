@@ -0,0 +1,116 @@
+package object
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3/s3test"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_body(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	source := filepath.Join(t.TempDir(), "index.html")
+	if err := os.WriteFile(source, []byte("<h1>hello</h1>"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		object  Object
+		want    string
+		wantErr bool
+	}{
+		{"content", Object{Content: types.StringValue("hello"), Source: types.StringNull()}, "hello", false},
+		{"empty content", Object{Content: types.StringValue(""), Source: types.StringNull()}, "", false},
+		{"source", Object{Content: types.StringNull(), Source: types.StringValue(source)}, "<h1>hello</h1>", false},
+		{"missing source", Object{Content: types.StringNull(), Source: types.StringValue(source + ".missing")}, "", true},
+		{"nothing", Object{Content: types.StringNull(), Source: types.StringNull()}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := body(tt.object)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("body() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("body() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_upload(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	srv := s3test.NewServer(t, "site")
+	plan := Object{
+		BucketURL:       types.StringValue(srv.BucketURL()),
+		Region:          types.StringValue("eu01"),
+		AccessKey:       types.StringValue(s3test.AccessKey),
+		SecretAccessKey: types.StringValue("secret"),
+		Key:             types.StringValue("index.html"),
+		Source:          types.StringNull(),
+		Content:         types.StringValue("hello"),
+		ContentType:     types.StringUnknown(),
+		Metadata:        map[string]string{"owner": "team"},
+	}
+
+	diags := diag.Diagnostics{}
+	upload(context.Background(), &diags, &plan)
+	if diags.HasError() {
+		t.Fatalf("upload() diags = %v", diags)
+	}
+	if plan.ID.ValueString() != "index.html" || plan.ETag.ValueString() != "5d41402abc4b2a76b9719d911017c592" || plan.RemoteETag.ValueString() != "5d41402abc4b2a76b9719d911017c592" || plan.ContentType.ValueString() != "binary/octet-stream" {
+		t.Errorf("upload() = %+v", plan)
+	}
+	if b, ok := srv.Object("index.html"); !ok || string(b) != "hello" {
+		t.Errorf("stored object = %s", b)
+	}
+}
+
+func Test_refreshETag(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name       string
+		etag       string
+		remoteETag types.String
+		remote     string
+		want       string
+	}{
+		{"unchanged", "5d41402abc4b2a76b9719d911017c592", types.StringValue("5d41402abc4b2a76b9719d911017c592"), "5d41402abc4b2a76b9719d911017c592", "5d41402abc4b2a76b9719d911017c592"},
+		{"encrypted", "5d41402abc4b2a76b9719d911017c592", types.StringValue("a1b2c3"), "a1b2c3", "5d41402abc4b2a76b9719d911017c592"},
+		{"changed", "5d41402abc4b2a76b9719d911017c592", types.StringValue("a1b2c3"), "d4e5f6", "d4e5f6"},
+		{"no remote ETag in state", "5d41402abc4b2a76b9719d911017c592", types.StringNull(), "a1b2c3", "5d41402abc4b2a76b9719d911017c592"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := Object{ETag: types.StringValue(tt.etag), RemoteETag: tt.remoteETag}
+			refreshETag(&state, tt.remote)
+			if state.ETag.ValueString() != tt.want {
+				t.Errorf("refreshETag() etag = %v, want %v", state.ETag.ValueString(), tt.want)
+			}
+			if state.RemoteETag.ValueString() != tt.remote {
+				t.Errorf("refreshETag() remote_etag = %v, want %v", state.RemoteETag.ValueString(), tt.remote)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// New returns a new configured resource
+func New() resource.Resource {
+	return &Resource{}
+}
+
+// Resource is the exported resource
+// objects are managed through the S3 API of the bucket
+// and don't require the STACKIT API client
+type Resource struct{}
+
+var _ = resource.Resource(&Resource{})
+var _ = resource.ResourceWithModifyPlan(&Resource{})
+
+// Metadata returns data resource metadata
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = "stackit_object_storage_object"
+}
@@ -0,0 +1,81 @@
+package object_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ObjectStorageObject(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "hello"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "id", "index.html"),
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "content_type", "text/html"),
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "metadata.owner", "team"),
+					// md5 of "hello"
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "etag", "5d41402abc4b2a76b9719d911017c592"),
+					resource.TestCheckResourceAttrSet("stackit_object_storage_object.example", "remote_etag"),
+				),
+			},
+			{
+				Config: config(name, "world"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "id", "index.html"),
+					// md5 of "world"
+					resource.TestCheckResourceAttr("stackit_object_storage_object.example", "etag", "7d793037a0760186574b0282f2f435e7"),
+				),
+			},
+		},
+	})
+}
+
+func config(name, content string) string {
+	return fmt.Sprintf(`
+resource "stackit_object_storage_credential" "example" {
+	project_id = "%s"
+}
+
+resource "stackit_object_storage_bucket" "example" {
+	project_id = stackit_object_storage_credential.example.project_id
+	name       = "%s"
+}
+
+resource "stackit_object_storage_object" "example" {
+	bucket_url        = stackit_object_storage_bucket.example.path_style_url
+	region            = stackit_object_storage_bucket.example.region
+	access_key        = stackit_object_storage_credential.example.access_key
+	secret_access_key = stackit_object_storage_credential.example.secret_access_key
+	key               = "index.html"
+	content           = "%s"
+	content_type      = "text/html"
+	metadata = {
+		owner = "team"
+	}
+}
+	  `,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+		content,
+	)
+}
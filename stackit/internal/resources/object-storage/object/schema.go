@@ -0,0 +1,136 @@
+package object
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Object is the schema model
+type Object struct {
+	ID              types.String      `tfsdk:"id"`
+	BucketURL       types.String      `tfsdk:"bucket_url"`
+	Region          types.String      `tfsdk:"region"`
+	AccessKey       types.String      `tfsdk:"access_key"`
+	SecretAccessKey types.String      `tfsdk:"secret_access_key"`
+	Key             types.String      `tfsdk:"key"`
+	Source          types.String      `tfsdk:"source"`
+	Content         types.String      `tfsdk:"content"`
+	ContentType     types.String      `tfsdk:"content_type"`
+	Metadata        map[string]string `tfsdk:"metadata"`
+	ETag            types.String      `tfsdk:"etag"`
+	RemoteETag      types.String      `tfsdk:"remote_etag"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages objects in an Object Storage bucket.\n\n" +
+			"The object is uploaded via the S3 API of the bucket using the keys of a `stackit_object_storage_credential`. " +
+			"Changes of the uploaded content are detected by comparing its MD5 checksum with the object's ETag, changes outside of Terraform by comparing the ETag returned by the S3 API with `remote_etag`. " +
+			"Since credentials aren't part of the import identifier, the resource doesn't support import.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (the object key)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"bucket_url": schema.StringAttribute{
+				Description: "the path style (`stackit_object_storage_bucket.path_style_url`) or host style (`stackit_object_storage_bucket.host_style_url`) URL of the bucket",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"region": schema.StringAttribute{
+				Description: "the region of the bucket (`stackit_object_storage_bucket.region`)",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(s3.DefaultRegion),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"access_key": schema.StringAttribute{
+				Description: "access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"secret_access_key": schema.StringAttribute{
+				Description: "secret access key of a `stackit_object_storage_credential`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"key": schema.StringAttribute{
+				Description: "the object key, i.e. its path in the bucket",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"source": schema.StringAttribute{
+				Description: "path to a local file to upload",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+
+			"content": schema.StringAttribute{
+				Description: "literal content to upload, for example rendered with `templatefile`",
+				Optional:    true,
+			},
+
+			"content_type": schema.StringAttribute{
+				Description: "the MIME type of the object. If not set, it's determined by the API.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"metadata": schema.MapAttribute{
+				Description: "user defined metadata, stored as `x-amz-meta-<key>` headers. Keys must be lowercase.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(metadataKeyRegex, "must only contain lowercase letters, numbers and hyphens"),
+					),
+				},
+			},
+
+			"etag": schema.StringAttribute{
+				Description: "the ETag of the object, i.e. the MD5 checksum of its content",
+				Computed:    true,
+			},
+
+			"remote_etag": schema.StringAttribute{
+				Description: "the ETag returned by the S3 API, used to detect changes of the object outside of Terraform. It differs from `etag` for server side encrypted objects.",
+				Computed:    true,
+			},
+		},
+	}
+}
@@ -6,11 +6,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/flavors"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/instance"
+	storageoptions "github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/storage"
 	"github.com/SchwarzIT/community-stackit-go-client/pkg/services/postgres-flex/v1.0/versions"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/cache"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
@@ -38,6 +43,67 @@ func (i *Instance) setDefaults() {
 	}
 }
 
+// ModifyPlan validates the instance against the available options
+// so invalid values fail during plan instead of during apply
+// checks whose values aren't known yet are skipped
+func (r Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to validate on destroy, without changes or before the provider is configured
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	// only read the attributes which are validated, the others may not be known yet
+	var plan, config Instance
+	for name, v := range map[string]interface{}{
+		"project_id":   &plan.ProjectID,
+		"version":      &plan.Version,
+		"machine_type": &plan.MachineType,
+		"storage":      &plan.Storage,
+	} {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), v)...)
+	}
+	for name, v := range map[string]interface{}{
+		"version": &config.Version,
+	} {
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), v)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// all options are listed per project
+	if plan.ProjectID.IsUnknown() {
+		return
+	}
+
+	if plan.Version.IsUnknown() && config.Version.IsNull() {
+		plan.Version = types.StringValue(DefaultVersion)
+	}
+	if !plan.Version.IsUnknown() {
+		if err := r.validateVersion(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.Version.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version"), "failed postgres validation", err.Error())
+		}
+	}
+	if !plan.MachineType.IsUnknown() {
+		if err := r.validateMachineType(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.MachineType.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("machine_type"), "failed postgres validation", err.Error())
+		}
+	}
+
+	// the storage options depend on the machine type
+	if plan.MachineType.IsUnknown() || plan.Storage.IsNull() || plan.Storage.IsUnknown() {
+		return
+	}
+	storage := Storage{}
+	resp.Diagnostics.Append(plan.Storage.As(ctx, &storage, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || storage.Class.IsUnknown() || storage.Size.IsUnknown() {
+		return
+	}
+	if err := r.validateStorage(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), plan.MachineType.ValueString(), storage); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("storage"), "failed postgres validation", err.Error())
+	}
+}
+
 func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data Instance) error {
 	if err := r.validateVersion(ctx, diags, data.ProjectID.ValueString(), data.Version.ValueString()); err != nil {
 		return err
@@ -63,9 +129,15 @@ func (r Resource) validate(ctx context.Context, diags *diag.Diagnostics, data In
 }
 
 func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics, projectID, version string) error {
-	res, err := r.client.PostgresFlex.Versions.List(ctx, projectID, &versions.ListParams{})
-	if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
-		return agg
+	res, err := cache.Get(cache.For(r.client), cache.PostgresFlexVersions, projectID, func() (*versions.ListResponse, error) {
+		res, err := r.client.PostgresFlex.Versions.List(ctx, projectID, &versions.ListParams{})
+		if agg := common.Validate(diags, res, err, "JSON200.Versions"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return err
 	}
 
 	opts := ""
@@ -79,9 +151,15 @@ func (r Resource) validateVersion(ctx context.Context, diags *diag.Diagnostics,
 }
 
 func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnostics, projectID, flavorID string) error {
-	res, err := r.client.PostgresFlex.Flavors.List(ctx, projectID)
-	if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
-		return agg
+	res, err := cache.Get(cache.For(r.client), cache.PostgresFlexFlavors, projectID, func() (*flavors.ListResponse, error) {
+		res, err := r.client.PostgresFlex.Flavors.List(ctx, projectID)
+		if agg := common.Validate(diags, res, err, "JSON200.Flavors"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return err
 	}
 
 	opts := ""
@@ -98,9 +176,15 @@ func (r Resource) validateMachineType(ctx context.Context, diags *diag.Diagnosti
 }
 
 func (r Resource) validateStorage(ctx context.Context, diags *diag.Diagnostics, projectID, machineType string, storage Storage) error {
-	res, err := r.client.PostgresFlex.Storage.GetStorageOptions(ctx, projectID, machineType)
-	if agg := common.Validate(diags, res, err, "JSON200.StorageClasses"); agg != nil {
-		return agg
+	res, err := cache.Get(cache.For(r.client), cache.PostgresFlexStorages, projectID+"/"+machineType, func() (*storageoptions.GetStorageOptionsResponse, error) {
+		res, err := r.client.PostgresFlex.Storage.GetStorageOptions(ctx, projectID, machineType)
+		if agg := common.Validate(diags, res, err, "JSON200.StorageClasses"); agg != nil {
+			return nil, agg
+		}
+		return res, nil
+	})
+	if err != nil {
+		return err
 	}
 
 	size := storage.Size.ValueInt64()
@@ -33,18 +33,9 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	}
 
 	var roles []string
-	var usingRoleSet bool = plan.Roles.IsUnknown()
-	if usingRoleSet {
-		resp.Diagnostics.Append(plan.RoleSet.ElementsAs(ctx, &roles, true)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-	} else {
-		// @TODO: remove roles in future release
-		resp.Diagnostics.Append(plan.Roles.ElementsAs(ctx, &roles, true)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	resp.Diagnostics.Append(plan.RoleSet.ElementsAs(ctx, &roles, true)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if len(roles) == 0 {
@@ -86,9 +77,6 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 	plan.URI = nullOrValStr(item.URI)
 	plan.RoleSet = types.SetValueMust(types.StringType, elems)
 
-	// @TODO: remove roles in future release
-	plan.Roles = types.ListValueMust(types.StringType, elems)
-
 	// update state with user
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -142,9 +130,6 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 	state.RoleSet = types.SetValueMust(types.StringType, roles)
 
-	// @TODO: remove roles in future release
-	state.Roles = types.ListValueMust(types.StringType, roles)
-
 	if state.URI.IsUnknown() {
 		state.URI = types.StringNull()
 	}
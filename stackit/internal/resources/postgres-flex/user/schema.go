@@ -7,7 +7,6 @@ import (
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
 	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -31,7 +30,6 @@ type User struct {
 	Host       types.String   `tfsdk:"host"`
 	Port       types.Int64    `tfsdk:"port"`
 	URI        types.String   `tfsdk:"uri"`
-	Roles      types.List     `tfsdk:"roles"`
 	RoleSet    types.Set      `tfsdk:"role_set"`
 	Timeouts   timeouts.Value `tfsdk:"timeouts"`
 }
@@ -39,6 +37,7 @@ type User struct {
 // Schema returns the terraform schema structure
 func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: fmt.Sprintf("Manages Postgres Flex instance users\n%s",
 			common.EnvironmentInfo(r.urls),
 		),
@@ -97,19 +96,6 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				Computed:    true,
 				Sensitive:   true,
 			},
-			// @TODO: remove in later release
-			"roles": schema.ListAttribute{
-				Description:        "Specifies the roles assigned to the user, valid options are: `login`, `createdb`",
-				DeprecationMessage: "The `roles` attribute is deprecated, use `role_set` instead.",
-				Optional:           true,
-				Computed:           true,
-				ElementType:        types.StringType,
-				Validators: []validator.List{
-					listvalidator.ValueStringsAre(
-						stringvalidator.OneOf("login", "createdb"),
-					),
-				},
-			},
 			"role_set": schema.SetAttribute{
 				Description: "Specifies the roles assigned to the user, valid options are: `login`, `createdb`",
 				Optional:    true,
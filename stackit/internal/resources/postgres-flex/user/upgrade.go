@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeState upgrades the state of previous schema versions
+func (r *Resource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	res := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, res)
+
+	return map[int64]resource.StateUpgrader{
+		// version 0 had the deprecated `roles` list
+		0: {
+			PriorSchema: common.PriorSchema(res.Schema, map[string]schema.Attribute{
+				"roles": schema.ListAttribute{
+					Optional:    true,
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+			}),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				common.UpgradeState(ctx, req, resp, migrateRolesV0)
+			},
+		},
+	}
+}
+
+// migrateRolesV0 sets `role_set` from `roles` if it isn't set yet
+func migrateRolesV0(attrs map[string]tftypes.Value) error {
+	roles, ok := attrs["roles"]
+	if !ok || !roles.IsKnown() || roles.IsNull() {
+		return nil
+	}
+	var elems []tftypes.Value
+	if err := roles.As(&elems); err != nil {
+		return err
+	}
+	attrs["roles"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+	common.MoveAttribute(attrs, "roles", "role_set")
+	return nil
+}
@@ -0,0 +1,48 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		fixture string
+		want    []string
+	}{
+		{"both set", `{"id":"id","roles":["login"],"role_set":["login","createdb"]}`, []string{"createdb", "login"}},
+		{"only deprecated set", `{"id":"id","roles":["createdb","login"],"role_set":null}`, []string{"createdb", "login"}},
+		{"none set", `{"id":"id","roles":null,"role_set":null}`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			state, diags := common.ApplyStateUpgrade(ctx, &Resource{}, 0, tt.fixture)
+			if diags.HasError() {
+				t.Fatalf("ApplyStateUpgrade() diagnostics: %v", diags)
+			}
+			var roleSet types.Set
+			if diags := state.GetAttribute(ctx, path.Root("role_set"), &roleSet); diags.HasError() {
+				t.Fatalf("failed to get role_set: %v", diags)
+			}
+			want := types.SetNull(types.StringType)
+			if tt.want != nil {
+				want, _ = types.SetValueFrom(ctx, types.StringType, tt.want)
+			}
+			if !roleSet.Equal(want) {
+				t.Errorf("role_set = %v, want %v", roleSet, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package projectmember
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Member
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := membership.MembersPayload{
+		ResourceType: membership.RESOURCE_TYPE_PROJECT,
+		Members: []membership.Member{
+			{
+				Role:    plan.Role.ValueString(),
+				Subject: plan.Subject.ValueString(),
+			},
+		},
+	}
+	res, err := r.client.Membership.AddMembers(ctx, plan.ProjectID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed adding project member", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(memberID(plan))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Member
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subject := state.Subject.ValueString()
+	res, err := r.client.Membership.GetMembers(ctx, membership.RESOURCE_TYPE_PROJECT, state.ProjectID.ValueString(), &membership.GetMembersParams{
+		Subject: &subject,
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading project members", agg.Error())
+		return
+	}
+
+	found := false
+	for _, m := range res.JSON200.Members {
+		if strings.EqualFold(m.Subject, subject) && m.Role == state.Role.ValueString() {
+			found = true
+			break
+		}
+	}
+
+	// the role was revoked outside of terraform
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(memberID(state))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require replacement
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Member
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := membership.MembersWithoutConditionPayload{
+		ResourceType: membership.RESOURCE_TYPE_PROJECT,
+		Members: []membership.MemberWithoutCondition{
+			{
+				Role:    state.Role.ValueString(),
+				Subject: state.Subject.ValueString(),
+			},
+		},
+	}
+	res, err := r.client.Membership.RemoveMembers(ctx, state.ProjectID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed removing project member", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,subject,role`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	// validate project id
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subject"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[2])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func memberID(m Member) string {
+	return fmt.Sprintf("%s,%s,%s", m.ProjectID.ValueString(), m.Subject.ValueString(), m.Role.ValueString())
+}
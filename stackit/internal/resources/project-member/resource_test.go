@@ -0,0 +1,73 @@
+package projectmember_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ProjectMember(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	user, ok := os.LookupEnv("ACC_TEST_USER_EMAIL")
+	if !ok {
+		t.Skip("Skipping TestAcc_ProjectMember: ACC_TEST_USER_EMAIL not specified")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(user, "project.auditor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_project_member.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_project_member.example", "subject", user),
+					resource.TestCheckResourceAttr("stackit_project_member.example", "role", "project.auditor"),
+					resource.TestCheckResourceAttr("stackit_project_member.example", "id", fmt.Sprintf("%s,%s,project.auditor", common.GetAcceptanceTestsProjectID(), user)),
+				),
+			},
+			// change role
+			{
+				Config: config(user, "project.member"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_project_member.example", "role", "project.member"),
+					resource.TestCheckResourceAttr("stackit_project_member.example", "id", fmt.Sprintf("%s,%s,project.member", common.GetAcceptanceTestsProjectID(), user)),
+				),
+			},
+			// test import
+			{
+				ResourceName:      "stackit_project_member.example",
+				ImportStateId:     fmt.Sprintf("%s,%s,project.member", common.GetAcceptanceTestsProjectID(), user),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(user, role string) string {
+	return fmt.Sprintf(`
+	resource "stackit_project_member" "example" {
+		project_id = "%s"
+		subject    = "%s"
+		role       = "%s"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		user,
+		role,
+	)
+}
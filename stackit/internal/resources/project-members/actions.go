@@ -0,0 +1,170 @@
+package projectmembers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Members
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []Member
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), desired)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ProjectID.ValueString())
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Members
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var declared []Member
+	if !state.Members.IsNull() && !state.Members.IsUnknown() {
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &declared, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	res, err := r.client.Membership.GetMembers(ctx, membership.RESOURCE_TYPE_PROJECT, state.ProjectID.ValueString(), &membership.GetMembersParams{})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading project members", agg.Error())
+		return
+	}
+
+	state.ID = types.StringValue(state.ProjectID.ValueString())
+	state.Members = r.toState(res.JSON200.Members, declared)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Members
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var desired []Member
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &resp.Diagnostics, plan.ProjectID.ValueString(), desired)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(plan.ProjectID.ValueString())
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Members
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var declared []Member
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &declared, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the managed role assignments are revoked, the provider's service account is kept
+	sa := r.client.Client.GetServiceAccountEmail()
+	members := []membership.MemberWithoutCondition{}
+	for _, m := range declared {
+		if strings.EqualFold(m.Subject.ValueString(), sa) {
+			continue
+		}
+		members = append(members, membership.MemberWithoutCondition{
+			Subject: m.Subject.ValueString(),
+			Role:    m.Role.ValueString(),
+		})
+	}
+
+	if len(members) > 0 {
+		res, err := r.client.Membership.RemoveMembers(ctx, state.ProjectID.ValueString(), membership.MembersWithoutConditionPayload{
+			ResourceType: membership.RESOURCE_TYPE_PROJECT,
+			Members:      members,
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			if res == nil || res.StatusCode() != http.StatusNotFound {
+				resp.Diagnostics.AddError("failed removing project members", agg.Error())
+				return
+			}
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// validate project id
+	if err := clientValidate.ProjectID(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,112 @@
+package projectmembers
+
+import (
+	"context"
+	"strings"
+
+	membership "github.com/SchwarzIT/community-stackit-go-client/pkg/services/membership/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// reconcile adds the missing role assignments and removes the ones not part of the desired set
+// the service account used by the provider is never removed to avoid locking terraform out of the project
+func (r Resource) reconcile(ctx context.Context, d *diag.Diagnostics, projectID string, desired []Member) {
+	res, err := r.client.Membership.GetMembers(ctx, membership.RESOURCE_TYPE_PROJECT, projectID, &membership.GetMembersParams{})
+	if agg := common.Validate(d, res, err, "JSON200"); agg != nil {
+		d.AddError("failed reading project members", agg.Error())
+		return
+	}
+	current := res.JSON200.Members
+
+	toAdd := []membership.Member{}
+	for _, m := range desired {
+		if !containsMember(current, m.Subject.ValueString(), m.Role.ValueString()) {
+			toAdd = append(toAdd, membership.Member{
+				Subject: m.Subject.ValueString(),
+				Role:    m.Role.ValueString(),
+			})
+		}
+	}
+
+	sa := r.client.Client.GetServiceAccountEmail()
+	toRemove := []membership.MemberWithoutCondition{}
+	for _, m := range current {
+		if strings.EqualFold(m.Subject, sa) {
+			continue
+		}
+		if !containsDesired(desired, m.Subject, m.Role) {
+			toRemove = append(toRemove, membership.MemberWithoutCondition{
+				Subject: m.Subject,
+				Role:    m.Role,
+			})
+		}
+	}
+
+	if len(toAdd) > 0 {
+		res, err := r.client.Membership.AddMembers(ctx, projectID, membership.MembersPayload{
+			ResourceType: membership.RESOURCE_TYPE_PROJECT,
+			Members:      toAdd,
+		})
+		if agg := common.Validate(d, res, err, "JSON200"); agg != nil {
+			d.AddError("failed adding project members", agg.Error())
+			return
+		}
+	}
+
+	if len(toRemove) > 0 {
+		res, err := r.client.Membership.RemoveMembers(ctx, projectID, membership.MembersWithoutConditionPayload{
+			ResourceType: membership.RESOURCE_TYPE_PROJECT,
+			Members:      toRemove,
+		})
+		if agg := common.Validate(d, res, err); agg != nil {
+			d.AddError("failed removing project members", agg.Error())
+			return
+		}
+	}
+}
+
+// toState converts the API members to the terraform set
+// the provider's service account is only reported if it was declared
+func (r Resource) toState(current []membership.Member, declared []Member) types.Set {
+	sa := r.client.Client.GetServiceAccountEmail()
+	elems := []attr.Value{}
+	for _, m := range current {
+		if strings.EqualFold(m.Subject, sa) && !containsDesired(declared, m.Subject, m.Role) {
+			continue
+		}
+		subject := m.Subject
+		// keep the casing used in the configuration
+		for _, dm := range declared {
+			if strings.EqualFold(dm.Subject.ValueString(), m.Subject) {
+				subject = dm.Subject.ValueString()
+				break
+			}
+		}
+		elems = append(elems, types.ObjectValueMust(memberType, map[string]attr.Value{
+			"subject": types.StringValue(subject),
+			"role":    types.StringValue(m.Role),
+		}))
+	}
+	return types.SetValueMust(types.ObjectType{AttrTypes: memberType}, elems)
+}
+
+func containsMember(members []membership.Member, subject, role string) bool {
+	for _, m := range members {
+		if strings.EqualFold(m.Subject, subject) && m.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+func containsDesired(members []Member, subject, role string) bool {
+	for _, m := range members {
+		if strings.EqualFold(m.Subject.ValueString(), subject) && m.Role.ValueString() == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package projectmembers_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ProjectMembers(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	user, ok := os.LookupEnv("ACC_TEST_USER_EMAIL")
+	if !ok {
+		t.Skip("Skipping TestAcc_ProjectMembers: ACC_TEST_USER_EMAIL not specified")
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(user, "project.owner"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_project_members.example", "id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_project_members.example", "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("stackit_project_members.example", "members.*", map[string]string{
+						"subject": user,
+						"role":    "project.owner",
+					}),
+				),
+			},
+			// change role
+			{
+				Config: config(user, "project.auditor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_project_members.example", "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("stackit_project_members.example", "members.*", map[string]string{
+						"subject": user,
+						"role":    "project.auditor",
+					}),
+				),
+			},
+			// test import
+			{
+				ResourceName:      "stackit_project_members.example",
+				ImportStateId:     common.GetAcceptanceTestsProjectID(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(user, role string) string {
+	return fmt.Sprintf(`
+	resource "stackit_project_members" "example" {
+		project_id = "%s"
+		members = [
+			{
+				subject = "%s"
+				role    = "%s"
+			}
+		]
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		user,
+		role,
+	)
+}
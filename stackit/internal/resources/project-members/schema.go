@@ -0,0 +1,88 @@
+package projectmembers
+
+import (
+	"context"
+	"fmt"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Members is the schema model
+type Members struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Members   types.Set    `tfsdk:"members"`
+}
+
+// Member is a single role assignment
+type Member struct {
+	Subject types.String `tfsdk:"subject"`
+	Role    types.String `tfsdk:"role"`
+}
+
+var memberType = map[string]attr.Type{
+	"subject": types.StringType,
+	"role":    types.StringType,
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Authoritatively manages the members of a STACKIT project. Role assignments that are not declared in this resource are removed from the project, except for the service account used by the provider.\n\n~> **Note:** Don't use `stackit_project_members` together with `stackit_project_member` for the same project, as they will conflict over the project's memberships.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Description: "The complete set of role assignments in the project.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subject": schema.StringAttribute{
+							Description: "Email address of the user or service account.",
+							Required:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role granted to the subject. Options: `project.owner`, `project.admin`, `project.member`, `project.auditor` (read only).",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(rmv2.PROJECT_OWNER),
+									string(rmv2.PROJECT_ADMIN),
+									string(rmv2.PROJECT_MEMBER),
+									string(rmv2.PROJECT_AUDITOR),
+								),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
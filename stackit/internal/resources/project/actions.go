@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"time"
 
 	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
@@ -13,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// networkAreaLabel binds a project to a network area
+const networkAreaLabel = "networkArea"
+
 // Create - lifecycle function
 func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan Project
@@ -35,6 +39,7 @@ func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *
 		Name:              types.StringValue(plan.Name.ValueString()),
 		BillingRef:        types.StringValue(plan.BillingRef.ValueString()),
 		OwnerEmail:        types.StringValue(plan.OwnerEmail.ValueString()),
+		NetworkAreaID:     plan.NetworkAreaID,
 		Timeouts:          plan.Timeouts,
 		Labels:            plan.Labels,
 	}
@@ -56,6 +61,10 @@ func (r Resource) createProject(ctx context.Context, resp *resource.CreateRespon
 		labels[k] = v
 	}
 
+	if !plan.NetworkAreaID.IsNull() {
+		labels[networkAreaLabel] = plan.NetworkAreaID.ValueString()
+	}
+
 	owner := rmv2.PROJECT_OWNER
 	subj1 := r.client.Client.GetServiceAccountEmail()
 	subj2 := plan.OwnerEmail.ValueString()
@@ -113,6 +122,10 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 
 	res, err := c.ResourceManagement.Get(ctx, p.ID.ValueString(), &rmv2.GetParams{})
 	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("failed reading project", agg.Error())
 		return
 	}
@@ -128,12 +141,30 @@ func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 			p.BillingRef = types.StringValue(v)
 		}
 
-		p.Labels = l
+		// labels added outside of terraform are kept to report drift
+		p.Labels = map[string]string{}
+		for k, v := range l {
+			p.Labels[k] = v
+		}
 
 		// delete "hidden" labels which we assign via attribute
 		// or similar to stay compatible with existing terraform code
 		delete(p.Labels, "billingReference")
 		delete(p.Labels, "scope")
+
+		// the network area label is only hidden if it's managed via attribute
+		if !p.NetworkAreaID.IsNull() {
+			p.NetworkAreaID = types.StringNull()
+			if v, ok := l[networkAreaLabel]; ok {
+				p.NetworkAreaID = types.StringValue(v)
+			}
+			delete(p.Labels, networkAreaLabel)
+		}
+	}
+
+	// an empty map would cause a diff against an unset labels attribute
+	if len(p.Labels) == 0 {
+		p.Labels = nil
 	}
 
 	diags = resp.State.Set(ctx, &p)
@@ -164,7 +195,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 	}
 
 	if plan.ContainerID.IsUnknown() {
-		plan.ID = state.ContainerID
+		plan.ContainerID = state.ContainerID
 	}
 
 	r.updateProject(ctx, plan, state, resp)
@@ -181,7 +212,7 @@ func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *
 }
 
 func (r Resource) updateProject(ctx context.Context, plan, state Project, resp *resource.UpdateResponse) {
-	if plan.Name.Equal(state.Name) && plan.BillingRef.Equal(state.BillingRef) {
+	if plan.Name.Equal(state.Name) && plan.BillingRef.Equal(state.BillingRef) && plan.NetworkAreaID.Equal(state.NetworkAreaID) && labelsEqual(plan.Labels, state.Labels) {
 		return
 	}
 
@@ -200,6 +231,10 @@ func (r Resource) updateProject(ctx context.Context, plan, state Project, resp *
 		labels[k] = v
 	}
 
+	if !plan.NetworkAreaID.IsNull() {
+		labels[networkAreaLabel] = plan.NetworkAreaID.ValueString()
+	}
+
 	name := plan.Name.ValueString()
 	parent := plan.ParentContainerID.ValueString()
 	body := rmv2.UpdateJSONRequestBody{
@@ -212,6 +247,40 @@ func (r Resource) updateProject(ctx context.Context, plan, state Project, resp *
 		resp.Diagnostics.AddError("failed updating project", agg.Error())
 		return
 	}
+
+	// explicitly remove labels that are no longer configured
+	removed := []interface{}{}
+	for k := range state.Labels {
+		if _, ok := labels[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	if _, ok := labels[networkAreaLabel]; !ok && !state.NetworkAreaID.IsNull() {
+		removed = append(removed, networkAreaLabel)
+	}
+	if len(removed) == 0 {
+		return
+	}
+
+	dres, err := r.client.ResourceManagement.DeleteProjectContainerIDLabels(ctx, plan.ContainerID.ValueString(), &rmv2.DeleteProjectContainerIDLabelsParams{
+		Keys: &removed,
+	})
+	if agg := common.Validate(&resp.Diagnostics, dres, err); agg != nil {
+		resp.Diagnostics.AddError("failed removing project labels", agg.Error())
+		return
+	}
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
 }
 
 // Delete - lifecycle function
@@ -63,6 +63,17 @@ func TestAcc_Project(t *testing.T) {
 					resource.TestCheckResourceAttr("stackit_project.example", "labels.age", "35"),
 				),
 			},
+			// label only change
+			{
+				Config: configWithLabels(newName, billingRef, user),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_project.example", "name", newName),
+					resource.TestCheckResourceAttr("stackit_project.example", "labels.%", "2"),
+					resource.TestCheckResourceAttr("stackit_project.example", "labels.name", "Emil"),
+					resource.TestCheckResourceAttr("stackit_project.example", "labels.env", "prod"),
+					resource.TestCheckNoResourceAttr("stackit_project.example", "labels.age"),
+				),
+			},
 			// test import
 			{
 				ResourceName:            "stackit_project.example",
@@ -93,3 +104,23 @@ func config(name, billingRef, user string) string {
 		schwarz_container_id,
 	)
 }
+
+func configWithLabels(name, billingRef, user string) string {
+	return fmt.Sprintf(`
+	resource "stackit_project" "example" {
+		name        = "%s"
+		billing_ref = "%s"
+		owner_email = "%s"
+		parent_container_id = "%s"
+		labels = {
+			name = "Emil"
+			env = "prod"
+		}
+	}
+	`,
+		name,
+		billingRef,
+		user,
+		schwarz_container_id,
+	)
+}
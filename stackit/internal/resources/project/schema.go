@@ -23,6 +23,7 @@ type Project struct {
 	Name              types.String      `tfsdk:"name"`
 	BillingRef        types.String      `tfsdk:"billing_ref"`
 	OwnerEmail        types.String      `tfsdk:"owner_email"`
+	NetworkAreaID     types.String      `tfsdk:"network_area_id"`
 	Timeouts          timeouts.Value    `tfsdk:"timeouts"`
 	Labels            map[string]string `tfsdk:"labels"`
 }
@@ -79,10 +80,18 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			},
 
 			"owner_email": schema.StringAttribute{
-				Description: "Email address of owner of the project. This value is only considered during creation. changing it afterwards will have no effect.",
+				Description: "Email address of owner of the project. This value is only considered during creation. changing it afterwards will have no effect. Use `stackit_project_member` or `stackit_project_members` to manage the project's members after creation.",
 				Required:    true,
 			},
 
+			"network_area_id": schema.StringAttribute{
+				Description: "the ID of the network area (`stackit_network_area`) the project is part of. Networks of the project are allocated from the network ranges of the area.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+
 			"timeouts": common.Timeouts(ctx, timeouts.Opts{
 				Create: true,
 				Delete: true,
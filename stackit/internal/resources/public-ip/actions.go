@@ -0,0 +1,148 @@
+package publicip
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan PublicIP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := iaas.CreatePublicIPRequest{
+		NetworkInterface: plan.NetworkInterfaceID.ValueStringPointer(),
+	}
+	if !plan.IP.IsUnknown() {
+		body.IP = plan.IP.ValueStringPointer()
+	}
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+
+	res, err := r.iaas.CreatePublicIP(ctx, plan.ProjectID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed creating public IP", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toState(ip iaas.PublicIP, state *PublicIP) {
+	state.ID = types.StringValue(ip.ID)
+	state.IP = types.StringValue(ip.IP)
+	state.NetworkInterfaceID = types.StringPointerValue(ip.NetworkInterface)
+
+	state.Labels = nil
+	if ip.Labels != nil && len(*ip.Labels) > 0 {
+		state.Labels = *ip.Labels
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state PublicIP
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetPublicIP(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading public IP", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state PublicIP
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := plan.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	res, err := r.iaas.UpdatePublicIP(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), iaas.UpdatePublicIPRequest{
+		NetworkInterface: plan.NetworkInterfaceID.ValueStringPointer(),
+		Labels:           &labels,
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed updating public IP", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state PublicIP
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.DeletePublicIP(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting public IP", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,public_ip_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,81 @@
+package publicip_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_PublicIP(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config("a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_public_ip.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_public_ip.example", "labels.key", "a"),
+					resource.TestCheckNoResourceAttr("stackit_public_ip.example", "network_interface_id"),
+					resource.TestCheckResourceAttrSet("stackit_public_ip.example", "ip"),
+					resource.TestCheckResourceAttrSet("stackit_public_ip.example", "id"),
+				),
+			},
+			// update labels
+			{
+				Config: config("b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_public_ip.example", "labels.key", "b"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_public_ip.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_public_ip.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_public_ip.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(label string) string {
+	return fmt.Sprintf(`
+	resource "stackit_public_ip" "example" {
+		project_id = "%s"
+		labels = {
+			"key" = "%s"
+		}
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		label,
+	)
+}
@@ -0,0 +1,76 @@
+package publicip
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PublicIP is the schema model
+type PublicIP struct {
+	ID                 types.String      `tfsdk:"id"`
+	ProjectID          types.String      `tfsdk:"project_id"`
+	IP                 types.String      `tfsdk:"ip"`
+	NetworkInterfaceID types.String      `tfsdk:"network_interface_id"`
+	Labels             map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Reserves a STACKIT public IP and optionally associates it with a network interface. The IP is kept when the associated server or network interface is replaced.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the public IP ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip": schema.StringAttribute{
+				Description: "the public IP address. If not set, a free address is reserved. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.PublicIP(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interface_id": schema.StringAttribute{
+				Description: "the ID of the network interface the public IP is associated with. Removing the value detaches the public IP.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "labels of the public IP",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package folder
+
+import (
+	"context"
+	"net/http"
+
+	rmv2 "github.com/SchwarzIT/community-stackit-go-client/pkg/services/resource-management/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Folder
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := rmv2.PostFoldersJSONRequestBody{
+		ContainerParentID: plan.ParentContainerID.ValueString(),
+		Name:              plan.Name.ValueString(),
+	}
+	if len(plan.Labels) > 0 {
+		labels := rmv2.ResourceLabels(plan.Labels)
+		body.Labels = &labels
+	}
+
+	res, err := r.client.ResourceManagement.PostFolders(ctx, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating folder", agg.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(res.JSON201.FolderID.String())
+	plan.ContainerID = types.StringValue(res.JSON201.ContainerID)
+
+	// update state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Folder
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ResourceManagement.GetFoldersContainerID(ctx, state.ContainerID.ValueString(), &rmv2.GetFoldersContainerIDParams{})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading folder", agg.Error())
+		return
+	}
+
+	folder := res.JSON200
+	state.ID = types.StringValue(folder.FolderID.String())
+	state.ContainerID = types.StringValue(folder.ContainerID)
+	state.ParentContainerID = types.StringValue(folder.Parent.ContainerID)
+	state.Name = types.StringValue(folder.Name)
+	state.Labels = nil
+	if folder.Labels != nil && len(*folder.Labels) > 0 {
+		state.Labels = *folder.Labels
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Folder
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state Folder
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.ContainerID = state.ContainerID
+
+	labels := rmv2.ResourceLabels{}
+	for k, v := range plan.Labels {
+		labels[k] = v
+	}
+
+	name := plan.Name.ValueString()
+	parent := plan.ParentContainerID.ValueString()
+	body := rmv2.PatchFoldersContainerIDJSONRequestBody{
+		ContainerParentID: &parent,
+		Labels:            &labels,
+		Name:              &name,
+	}
+	res, err := r.client.ResourceManagement.PatchFoldersContainerID(ctx, plan.ContainerID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		resp.Diagnostics.AddError("failed updating folder", agg.Error())
+		return
+	}
+
+	// explicitly remove labels that are no longer configured
+	removed := []interface{}{}
+	for k := range state.Labels {
+		if _, ok := labels[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	if len(removed) > 0 {
+		dres, err := r.client.ResourceManagement.DeleteFolderLabels(ctx, plan.ContainerID.ValueString(), &rmv2.DeleteFolderLabelsParams{
+			Keys: &removed,
+		})
+		if agg := common.Validate(&resp.Diagnostics, dres, err); agg != nil {
+			resp.Diagnostics.AddError("failed removing folder labels", agg.Error())
+			return
+		}
+	}
+
+	// update state
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Folder
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ResourceManagement.DeleteFoldersContainerID(ctx, state.ContainerID.ValueString(), &rmv2.DeleteFoldersContainerIDParams{})
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting folder", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected the folder container ID as import identifier",
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container_id"), req.ID)...)
+}
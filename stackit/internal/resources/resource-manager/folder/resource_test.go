@@ -0,0 +1,115 @@
+package folder_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	run_this_test        = false
+	schwarz_container_id = "schwarz-it-kg-WJACUK1"
+)
+
+func TestAcc_ResourceManagerFolder(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "AccTest " + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	newName := "AccTest " + acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("stackit_resource_manager_folder.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_resource_manager_folder.example", "container_id"),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "parent_container_id", schwarz_container_id),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "labels.%", "1"),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "labels.env", "dev"),
+				),
+			},
+			// rename and relabel
+			{
+				Config: config(newName, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "name", newName),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "labels.%", "1"),
+					resource.TestCheckResourceAttr("stackit_resource_manager_folder.example", "labels.env", "prod"),
+				),
+			},
+			// move into a sibling folder
+			{
+				Config: configMoved(newName, "prod"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_resource_manager_folder.example", "parent_container_id", "stackit_resource_manager_folder.parent", "container_id"),
+				),
+			},
+			// test import
+			{
+				ResourceName:      "stackit_resource_manager_folder.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_resource_manager_folder.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_resource_manager_folder.example")
+					}
+					return r.Primary.Attributes["container_id"], nil
+				},
+			},
+		},
+	})
+}
+
+func config(name, env string) string {
+	return fmt.Sprintf(`
+	resource "stackit_resource_manager_folder" "example" {
+		name                = "%s"
+		parent_container_id = "%s"
+		labels = {
+			env = "%s"
+		}
+	}
+	`,
+		name,
+		schwarz_container_id,
+		env,
+	)
+}
+
+func configMoved(name, env string) string {
+	return fmt.Sprintf(`
+	resource "stackit_resource_manager_folder" "parent" {
+		name                = "%s parent"
+		parent_container_id = "%s"
+	}
+
+	resource "stackit_resource_manager_folder" "example" {
+		name                = "%s"
+		parent_container_id = stackit_resource_manager_folder.parent.container_id
+		labels = {
+			env = "%s"
+		}
+	}
+	`,
+		name,
+		schwarz_container_id,
+		name,
+		env,
+	)
+}
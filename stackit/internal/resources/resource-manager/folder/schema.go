@@ -0,0 +1,69 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Folder is the schema model
+type Folder struct {
+	ID                types.String      `tfsdk:"id"`
+	ContainerID       types.String      `tfsdk:"container_id"`
+	ParentContainerID types.String      `tfsdk:"parent_container_id"`
+	Name              types.String      `tfsdk:"name"`
+	Labels            map[string]string `tfsdk:"labels"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages STACKIT resource manager folders\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the folder ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"container_id": schema.StringAttribute{
+				Description: "the folder container ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"parent_container_id": schema.StringAttribute{
+				Description: "the container ID of the organization or folder in which the folder will be created. Changing this value moves the folder.",
+				Required:    true,
+			},
+
+			"name": schema.StringAttribute{
+				Description: "the folder name",
+				Required:    true,
+				Validators: []validator.String{
+					validate.FolderName(),
+				},
+			},
+
+			"labels": schema.MapAttribute{
+				Description: "Extend folder information with custom label values.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/vault"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(plan)
+	mount, p := plan.InstanceID.ValueString(), plan.Path.ValueString()
+	m, err := c.WriteSecret(ctx, mount, p, plan.Data)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing secret", err.Error())
+		return
+	}
+
+	if plan.CustomMetadata != nil || !plan.MaxVersions.IsNull() {
+		if err := c.WriteMetadata(ctx, mount, p, plan.CustomMetadata, plan.MaxVersions.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("failed writing secret metadata", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s,%s", mount, p))
+	plan.Version = types.Int64Value(m.Version)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(state)
+	mount, p := state.InstanceID.ValueString(), state.Path.ValueString()
+
+	// deleted latest versions are reported as not found as well
+	s, err := c.ReadSecret(ctx, mount, p, 0)
+	if err != nil {
+		if vault.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading secret", err.Error())
+		return
+	}
+
+	m, err := c.ReadMetadata(ctx, mount, p)
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading secret metadata", err.Error())
+		return
+	}
+
+	state.Data = s.StringData()
+	state.Version = types.Int64Value(s.Metadata.Version)
+	state.CustomMetadata = m.Custom
+	if len(state.CustomMetadata) == 0 {
+		state.CustomMetadata = nil
+	}
+	if !state.MaxVersions.IsNull() || m.MaxVersions != 0 {
+		state.MaxVersions = types.Int64Value(m.MaxVersions)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Secret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(plan)
+	mount, p := plan.InstanceID.ValueString(), plan.Path.ValueString()
+
+	plan.ID = state.ID
+	plan.Version = state.Version
+	if !mapsEqual(plan.Data, state.Data) {
+		m, err := c.WriteSecret(ctx, mount, p, plan.Data)
+		if err != nil {
+			resp.Diagnostics.AddError("failed writing secret", err.Error())
+			return
+		}
+		plan.Version = types.Int64Value(m.Version)
+	}
+
+	if !mapsEqual(plan.CustomMetadata, state.CustomMetadata) || !plan.MaxVersions.Equal(state.MaxVersions) {
+		if err := c.WriteMetadata(ctx, mount, p, plan.CustomMetadata, plan.MaxVersions.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("failed writing secret metadata", err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Secret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c := client(state)
+	if err := c.DeleteMetadata(ctx, state.InstanceID.ValueString(), state.Path.ValueString()); err != nil && !vault.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed deleting secret", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func client(s Secret) *vault.Client {
+	return vault.New(s.APIURL.ValueString(), s.Username.ValueString(), s.Password.ValueString())
+}
+
+func mapsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package secret
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/vault/vaulttest"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const instanceID = "6b1b0a8c-9c2a-4e0e-9d3b-2c8f4f1e8a11"
+
+func TestSecretLifecycle(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := vaulttest.NewServer(t, instanceID)
+	r := Resource{}
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	null := tftypes.NewValue(s.Type().TerraformType(ctx), nil)
+
+	plan := Secret{
+		ID:             types.StringUnknown(),
+		InstanceID:     types.StringValue(instanceID),
+		APIURL:         types.StringValue(srv.URL),
+		Username:       types.StringValue(vaulttest.Username),
+		Password:       types.StringValue(vaulttest.Password),
+		Path:           types.StringValue("databases/orders"),
+		Data:           map[string]string{"password": "first"},
+		CustomMetadata: map[string]string{"owner": "team"},
+		MaxVersions:    types.Int64Null(),
+		Version:        types.Int64Unknown(),
+	}
+
+	// create
+	p := tfsdk.Plan{Schema: s, Raw: null}
+	if d := p.Set(ctx, &plan); d.HasError() {
+		t.Fatal(d)
+	}
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: null}}
+	r.Create(ctx, resource.CreateRequest{Plan: p}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatal(createResp.Diagnostics)
+	}
+
+	// read
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	state := Secret{}
+	readResp.State.Get(ctx, &state)
+	if state.ID.ValueString() != instanceID+",databases/orders" || state.Version.ValueInt64() != 1 || state.Data["password"] != "first" || state.CustomMetadata["owner"] != "team" || !state.MaxVersions.IsNull() {
+		t.Fatalf("Read() = %+v", state)
+	}
+
+	// update data creates a new version
+	plan = state
+	plan.Data = map[string]string{"password": "second"}
+	plan.MaxVersions = types.Int64Value(3)
+	p = tfsdk.Plan{Schema: s, Raw: null}
+	p.Set(ctx, &plan)
+	updateResp := resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{Plan: p, State: readResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatal(updateResp.Diagnostics)
+	}
+	updateResp.State.Get(ctx, &state)
+	if state.Version.ValueInt64() != 2 || srv.Versions("databases/orders") != 2 {
+		t.Fatalf("Update() = %+v", state)
+	}
+
+	// deleted secrets are removed from the state
+	srv.SoftDelete("databases/orders")
+	readResp = resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() || !readResp.State.Raw.IsNull() {
+		t.Fatalf("Read() expected resource to be removed, diags = %v", readResp.Diagnostics)
+	}
+
+	// delete
+	deleteResp := resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatal(deleteResp.Diagnostics)
+	}
+	if srv.Versions("databases/orders") != 0 {
+		t.Error("expected secret to be deleted")
+	}
+}
@@ -0,0 +1,24 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// New returns a new configured resource
+func New() resource.Resource {
+	return &Resource{}
+}
+
+// Resource is the exported resource
+// secrets are managed through the Vault compatible API of the instance
+// and don't require the STACKIT API client
+type Resource struct{}
+
+var _ = resource.Resource(&Resource{})
+
+// Metadata returns data resource metadata
+func (r *Resource) Metadata(_ context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	res.TypeName = "stackit_secrets_manager_secret"
+}
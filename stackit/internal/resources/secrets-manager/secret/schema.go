@@ -0,0 +1,119 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Secret is the schema model
+type Secret struct {
+	ID             types.String      `tfsdk:"id"`
+	InstanceID     types.String      `tfsdk:"instance_id"`
+	APIURL         types.String      `tfsdk:"api_url"`
+	Username       types.String      `tfsdk:"username"`
+	Password       types.String      `tfsdk:"password"`
+	Path           types.String      `tfsdk:"path"`
+	Data           map[string]string `tfsdk:"data"`
+	CustomMetadata map[string]string `tfsdk:"custom_metadata"`
+	MaxVersions    types.Int64       `tfsdk:"max_versions"`
+	Version        types.Int64       `tfsdk:"version"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages secrets of a Secrets Manager instance.\n\n" +
+			"Secrets are stored in the instance's KV version 2 secrets engine via its Vault compatible API, " +
+			"authenticated with the credentials of a `stackit_secrets_manager_user` with `write_enabled`. " +
+			"Every change of `data` creates a new secret version. Deleting the resource deletes all versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Specifies the resource ID (`instance_id,path`)",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			"instance_id": schema.StringAttribute{
+				Description: "the Secrets Manager instance ID, which is the mount path of the secrets engine",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"api_url": schema.StringAttribute{
+				Description: "the API URL of the instance (`stackit_secrets_manager_instance.api_url`)",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"username": schema.StringAttribute{
+				Description: "username of a `stackit_secrets_manager_user`",
+				Required:    true,
+			},
+
+			"password": schema.StringAttribute{
+				Description: "password of a `stackit_secrets_manager_user`",
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"path": schema.StringAttribute{
+				Description: "the secret path, e.g. `databases/orders`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			"data": schema.MapAttribute{
+				Description: "the secret's key value pairs",
+				Required:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+
+			"custom_metadata": schema.MapAttribute{
+				Description: "custom metadata of the secret, which is not versioned",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			"max_versions": schema.Int64Attribute{
+				Description: "the number of versions to keep. If not set, the default of the instance applies.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+
+			"version": schema.Int64Attribute{
+				Description: "the current version of the secret",
+				Computed:    true,
+			},
+		},
+	}
+}
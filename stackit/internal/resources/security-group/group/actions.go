@@ -0,0 +1,160 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SecurityGroup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateful := plan.Stateful.ValueBool()
+	body := iaas.CreateSecurityGroupRequest{
+		Name:     plan.Name.ValueString(),
+		Stateful: &stateful,
+	}
+	if !plan.Description.IsNull() {
+		description := plan.Description.ValueString()
+		body.Description = &description
+	}
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+
+	res, err := r.iaas.CreateSecurityGroup(ctx, plan.ProjectID.ValueString(), body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating security group %s", body.Name), agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toState(sg iaas.SecurityGroup, state *SecurityGroup) {
+	state.ID = types.StringValue(sg.ID)
+	state.Name = types.StringValue(sg.Name)
+	state.Description = types.StringNull()
+	if sg.Description != nil && *sg.Description != "" {
+		state.Description = types.StringValue(*sg.Description)
+	}
+	if sg.Stateful != nil {
+		state.Stateful = types.BoolValue(*sg.Stateful)
+	}
+
+	state.Labels = nil
+	if sg.Labels != nil && len(*sg.Labels) > 0 {
+		state.Labels = *sg.Labels
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SecurityGroup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetSecurityGroup(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading security group", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SecurityGroup
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	labels := plan.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	res, err := r.iaas.UpdateSecurityGroup(ctx, state.ProjectID.ValueString(), state.ID.ValueString(), iaas.UpdateSecurityGroupRequest{
+		Name:        &name,
+		Description: &description,
+		Labels:      &labels,
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed updating security group", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SecurityGroup
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.DeleteSecurityGroup(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting security group", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,security_group_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
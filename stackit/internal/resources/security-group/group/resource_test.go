@@ -0,0 +1,87 @@
+package group_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_SecurityGroup(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_security_group.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_security_group.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_security_group.example", "stateful", "true"),
+					resource.TestCheckResourceAttr("stackit_security_group.example", "labels.key", "a"),
+					resource.TestCheckResourceAttrSet("stackit_security_group.example", "id"),
+				),
+			},
+			// update labels
+			{
+				Config: config(name, "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_security_group.example", "labels.key", "b"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_security_group.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_security_group.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_security_group.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name, label string) string {
+	return fmt.Sprintf(`
+	resource "stackit_security_group" "example" {
+		name        = "%s"
+		project_id  = "%s"
+		description = "acceptance test"
+		labels = {
+			"key" = "%s"
+		}
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		label,
+	)
+}
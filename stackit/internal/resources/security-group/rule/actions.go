@@ -0,0 +1,181 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Rule
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.CreateSecurityGroupRule(ctx, plan.ProjectID.ValueString(), plan.SecurityGroupID.ValueString(), toCreateRequest(plan))
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed creating security group rule", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toCreateRequest(plan Rule) iaas.SecurityGroupRule {
+	etherType := plan.EtherType.ValueString()
+	body := iaas.SecurityGroupRule{
+		Direction: plan.Direction.ValueString(),
+		Ethertype: &etherType,
+	}
+	if !plan.Description.IsNull() {
+		description := plan.Description.ValueString()
+		body.Description = &description
+	}
+	if !plan.Protocol.IsNull() {
+		p := plan.Protocol.ValueString()
+		if n, err := strconv.ParseInt(p, 10, 64); err == nil {
+			body.Protocol = &iaas.SecurityGroupProtocol{Number: &n}
+		} else {
+			p = strings.ToLower(p)
+			body.Protocol = &iaas.SecurityGroupProtocol{Name: &p}
+		}
+	}
+	if !plan.PortRangeMin.IsNull() {
+		body.PortRange = &iaas.PortRange{
+			Min: plan.PortRangeMin.ValueInt64(),
+			Max: plan.PortRangeMax.ValueInt64(),
+		}
+	}
+	if !plan.RemoteCIDR.IsNull() {
+		cidr := plan.RemoteCIDR.ValueString()
+		body.IPRange = &cidr
+	}
+	if !plan.RemoteSecurityGroupID.IsNull() {
+		sg := plan.RemoteSecurityGroupID.ValueString()
+		body.RemoteSecurityGroupID = &sg
+	}
+	return body
+}
+
+func toState(rule iaas.SecurityGroupRule, state *Rule) {
+	state.ID = types.StringValue(rule.ID)
+	state.Direction = types.StringValue(rule.Direction)
+	if rule.SecurityGroupID != "" {
+		state.SecurityGroupID = types.StringValue(rule.SecurityGroupID)
+	}
+	if rule.Ethertype != nil {
+		state.EtherType = types.StringValue(*rule.Ethertype)
+	}
+
+	state.Description = types.StringNull()
+	if rule.Description != nil && *rule.Description != "" {
+		state.Description = types.StringValue(*rule.Description)
+	}
+
+	// keep the configured protocol representation (name or number)
+	if p := rule.Protocol; p == nil {
+		state.Protocol = types.StringNull()
+	} else if _, err := strconv.ParseInt(state.Protocol.ValueString(), 10, 64); err == nil && p.Number != nil {
+		state.Protocol = types.StringValue(strconv.FormatInt(*p.Number, 10))
+	} else if p.Name != nil {
+		if !strings.EqualFold(state.Protocol.ValueString(), *p.Name) {
+			state.Protocol = types.StringValue(*p.Name)
+		}
+	} else if p.Number != nil {
+		state.Protocol = types.StringValue(strconv.FormatInt(*p.Number, 10))
+	}
+
+	state.PortRangeMin = types.Int64Null()
+	state.PortRangeMax = types.Int64Null()
+	if rule.PortRange != nil {
+		state.PortRangeMin = types.Int64Value(rule.PortRange.Min)
+		state.PortRangeMax = types.Int64Value(rule.PortRange.Max)
+	}
+
+	state.RemoteCIDR = types.StringPointerValue(rule.IPRange)
+	state.RemoteSecurityGroupID = types.StringPointerValue(rule.RemoteSecurityGroupID)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Rule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetSecurityGroupRule(ctx, state.ProjectID.ValueString(), state.SecurityGroupID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading security group rule", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require the resource to be recreated
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Rule
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.DeleteSecurityGroupRule(ctx, state.ProjectID.ValueString(), state.SecurityGroupID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if !clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.Diagnostics.AddError("failed deleting security group rule", agg.Error())
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,security_group_id,rule_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("security_group_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
+}
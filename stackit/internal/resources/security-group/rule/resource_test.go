@@ -0,0 +1,102 @@
+package rule_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_SecurityGroupRule(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_security_group_rule.ssh", "security_group_id", "stackit_security_group.example", "id"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "direction", "ingress"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "ether_type", "IPv4"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "port_range_min", "22"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "port_range_max", "22"),
+					resource.TestCheckResourceAttr("stackit_security_group_rule.ssh", "remote_cidr", "10.0.0.0/8"),
+					resource.TestCheckResourceAttrSet("stackit_security_group_rule.ssh", "id"),
+					resource.TestCheckResourceAttrPair("stackit_security_group_rule.internal", "remote_security_group_id", "stackit_security_group.example", "id"),
+					resource.TestCheckNoResourceAttr("stackit_security_group_rule.internal", "protocol"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_security_group_rule.ssh",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_security_group_rule.ssh"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_security_group_rule.ssh")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+					sg, ok := r.Primary.Attributes["security_group_id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute security_group_id")
+					}
+
+					return fmt.Sprintf("%s,%s,%s", common.GetAcceptanceTestsProjectID(), sg, id), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_security_group" "example" {
+		name       = "%[1]s"
+		project_id = "%[2]s"
+	}
+
+	resource "stackit_security_group_rule" "ssh" {
+		project_id        = "%[2]s"
+		security_group_id = stackit_security_group.example.id
+		description       = "ssh"
+		direction         = "ingress"
+		protocol          = "tcp"
+		port_range_min    = 22
+		port_range_max    = 22
+		remote_cidr       = "10.0.0.0/8"
+	}
+
+	resource "stackit_security_group_rule" "internal" {
+		project_id               = "%[2]s"
+		security_group_id        = stackit_security_group.example.id
+		direction                = "ingress"
+		remote_security_group_id = stackit_security_group.example.id
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+	)
+}
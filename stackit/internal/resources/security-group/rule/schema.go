@@ -0,0 +1,158 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Rule is the schema model
+type Rule struct {
+	ID                    types.String `tfsdk:"id"`
+	ProjectID             types.String `tfsdk:"project_id"`
+	SecurityGroupID       types.String `tfsdk:"security_group_id"`
+	Description           types.String `tfsdk:"description"`
+	Direction             types.String `tfsdk:"direction"`
+	EtherType             types.String `tfsdk:"ether_type"`
+	Protocol              types.String `tfsdk:"protocol"`
+	PortRangeMin          types.Int64  `tfsdk:"port_range_min"`
+	PortRangeMax          types.Int64  `tfsdk:"port_range_max"`
+	RemoteCIDR            types.String `tfsdk:"remote_cidr"`
+	RemoteSecurityGroupID types.String `tfsdk:"remote_security_group_id"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a rule of a STACKIT security group. Rules can't be updated, any change requires the rule to be recreated.\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the security group rule ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Description: "the ID of the security group the rule belongs to",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "the rule description",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(127),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Description: "the traffic direction the rule applies to. Options: `ingress`, `egress`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(directionIngress, directionEgress),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ether_type": schema.StringAttribute{
+				Description: "the ether type the rule applies to. Options: `IPv4`, `IPv6`. Default is `IPv4`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(etherTypeIPv4),
+				Validators: []validator.String{
+					stringvalidator.OneOf(etherTypeIPv4, etherTypeIPv6),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"protocol": schema.StringAttribute{
+				Description: "the protocol name (for example `tcp`, `udp`, `icmp`, `icmpv6`) or IP protocol number the rule applies to. If not set, the rule applies to all protocols",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_min": schema.Int64Attribute{
+				Description: "the lower bound of the port range. Only supported for `tcp` and `udp`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AlsoRequires(path.MatchRoot("port_range_max")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"port_range_max": schema.Int64Attribute{
+				Description: "the upper bound of the port range. Only supported for `tcp` and `udp`",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+					int64validator.AlsoRequires(path.MatchRoot("port_range_min")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"remote_cidr": schema.StringAttribute{
+				Description: "the remote IP range in CIDR notation the rule applies to, for example `10.0.0.0/8`",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.CIDR(),
+					stringvalidator.ConflictsWith(path.MatchRoot("remote_security_group_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_security_group_id": schema.StringAttribute{
+				Description: "the ID of the remote security group the rule applies to",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	directionIngress = "ingress"
+	directionEgress  = "egress"
+	etherTypeIPv4    = "IPv4"
+	etherTypeIPv6    = "IPv6"
+)
+
+var _ = resource.ResourceWithValidateConfig(&Resource{})
+
+// ValidateConfig validates the combination of rule attributes
+func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Rule
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateRule(config); err != nil {
+		resp.Diagnostics.AddError("invalid security group rule", err.Error())
+	}
+}
+
+func validateRule(rule Rule) error {
+	if !rule.PortRangeMin.IsNull() && !rule.PortRangeMax.IsNull() &&
+		!rule.PortRangeMin.IsUnknown() && !rule.PortRangeMax.IsUnknown() {
+		if rule.PortRangeMin.ValueInt64() > rule.PortRangeMax.ValueInt64() {
+			return fmt.Errorf("port_range_min (%d) must be lower or equal to port_range_max (%d)", rule.PortRangeMin.ValueInt64(), rule.PortRangeMax.ValueInt64())
+		}
+		if !rule.Protocol.IsUnknown() {
+			p := strings.ToLower(rule.Protocol.ValueString())
+			if p != "tcp" && p != "udp" {
+				return fmt.Errorf("port ranges are only supported for protocols tcp and udp, got %q", rule.Protocol.ValueString())
+			}
+		}
+	}
+
+	if rule.RemoteCIDR.IsNull() || rule.RemoteCIDR.IsUnknown() || rule.EtherType.IsUnknown() {
+		return nil
+	}
+
+	etherType := rule.EtherType.ValueString()
+	if etherType == "" {
+		etherType = etherTypeIPv4
+	}
+	ip, _, err := net.ParseCIDR(rule.RemoteCIDR.ValueString())
+	if err != nil {
+		// reported by the attribute validator
+		return nil
+	}
+	if isV4 := ip.To4() != nil; isV4 != (etherType == etherTypeIPv4) {
+		return fmt.Errorf("remote_cidr %s doesn't match ether_type %s", rule.RemoteCIDR.ValueString(), etherType)
+	}
+	return nil
+}
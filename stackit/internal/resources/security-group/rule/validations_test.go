@@ -0,0 +1,79 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Test_validateRule(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"minimal", Rule{
+			EtherType:    types.StringNull(),
+			Protocol:     types.StringNull(),
+			PortRangeMin: types.Int64Null(),
+			PortRangeMax: types.Int64Null(),
+			RemoteCIDR:   types.StringNull(),
+		}, false},
+		{"tcp port range", Rule{
+			EtherType:    types.StringValue(etherTypeIPv4),
+			Protocol:     types.StringValue("tcp"),
+			PortRangeMin: types.Int64Value(22),
+			PortRangeMax: types.Int64Value(22),
+			RemoteCIDR:   types.StringValue("10.0.0.0/8"),
+		}, false},
+		{"inverted port range", Rule{
+			EtherType:    types.StringValue(etherTypeIPv4),
+			Protocol:     types.StringValue("tcp"),
+			PortRangeMin: types.Int64Value(443),
+			PortRangeMax: types.Int64Value(80),
+			RemoteCIDR:   types.StringNull(),
+		}, true},
+		{"port range without tcp/udp", Rule{
+			EtherType:    types.StringValue(etherTypeIPv4),
+			Protocol:     types.StringValue("icmp"),
+			PortRangeMin: types.Int64Value(1),
+			PortRangeMax: types.Int64Value(2),
+			RemoteCIDR:   types.StringNull(),
+		}, true},
+		{"port range without protocol", Rule{
+			EtherType:    types.StringValue(etherTypeIPv4),
+			Protocol:     types.StringNull(),
+			PortRangeMin: types.Int64Value(1),
+			PortRangeMax: types.Int64Value(2),
+			RemoteCIDR:   types.StringNull(),
+		}, true},
+		{"v6 cidr with v4 ether type", Rule{
+			EtherType:    types.StringValue(etherTypeIPv4),
+			Protocol:     types.StringNull(),
+			PortRangeMin: types.Int64Null(),
+			PortRangeMax: types.Int64Null(),
+			RemoteCIDR:   types.StringValue("2001:db8::/32"),
+		}, true},
+		{"v6 cidr with v6 ether type", Rule{
+			EtherType:    types.StringValue(etherTypeIPv6),
+			Protocol:     types.StringNull(),
+			PortRangeMin: types.Int64Null(),
+			PortRangeMax: types.Int64Null(),
+			RemoteCIDR:   types.StringValue("2001:db8::/32"),
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateRule(tt.rule); (err != nil) != tt.wantErr {
+				t.Errorf("validateRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
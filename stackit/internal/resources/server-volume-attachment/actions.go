@@ -0,0 +1,150 @@
+package servervolumeattachment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Attachment
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	serverID := plan.ServerID.ValueString()
+	volumeID := plan.VolumeID.ValueString()
+
+	body := iaas.VolumeAttachment{}
+	if !plan.DeleteOnTermination.IsNull() && !plan.DeleteOnTermination.IsUnknown() {
+		del := plan.DeleteOnTermination.ValueBool()
+		body.DeleteOnTermination = &del
+	}
+
+	res, err := r.iaas.AttachVolume(ctx, projectID, serverID, volumeID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed attaching volume %s to server %s", volumeID, serverID), agg.Error())
+		return
+	}
+
+	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.iaas.VolumeAttachmentWaitHandler(ctx, projectID, serverID, volumeID).SetTimeout(timeout).WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed validating volume attachment", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(strings.Join([]string{projectID, serverID, volumeID}, ","))
+	plan.DeleteOnTermination = types.BoolValue(false)
+	if res.Result != nil && res.Result.DeleteOnTermination != nil {
+		plan.DeleteOnTermination = types.BoolValue(*res.Result.DeleteOnTermination)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Attachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetVolumeAttachment(ctx, state.ProjectID.ValueString(), state.ServerID.ValueString(), state.VolumeID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading volume attachment", agg.Error())
+		return
+	}
+
+	state.DeleteOnTermination = types.BoolValue(false)
+	if res.Result.DeleteOnTermination != nil {
+		state.DeleteOnTermination = types.BoolValue(*res.Result.DeleteOnTermination)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// all attributes require the resource to be recreated
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Attachment
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	serverID := state.ServerID.ValueString()
+	volumeID := state.VolumeID.ValueString()
+
+	res, err := r.iaas.DetachVolume(ctx, projectID, serverID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed detaching volume", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.iaas.VolumeDetachmentWaitHandler(ctx, projectID, serverID, volumeID).SetTimeout(timeout).WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify volume detachment", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,server_id,volume_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), idParts[2])...)
+}
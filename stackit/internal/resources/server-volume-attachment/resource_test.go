@@ -0,0 +1,90 @@
+package servervolumeattachment_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ServerVolumeAttachment(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	image, ok := os.LookupEnv("ACC_TEST_IMAGE_ID")
+	if !ok {
+		t.Skip("Skipping TestAcc_ServerVolumeAttachment: ACC_TEST_IMAGE_ID not specified")
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, image),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_server_volume_attachment.example", "server_id", "stackit_server.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_server_volume_attachment.example", "volume_id", "stackit_volume.example", "id"),
+					resource.TestCheckResourceAttr("stackit_server_volume_attachment.example", "delete_on_termination", "false"),
+					resource.TestCheckResourceAttrSet("stackit_server_volume_attachment.example", "id"),
+				),
+			},
+			// test import
+			{
+				ResourceName:            "stackit_server_volume_attachment.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(name, image string) string {
+	return fmt.Sprintf(`
+	resource "stackit_network" "example" {
+		name       = "%[1]s"
+		project_id = "%[2]s"
+	}
+
+	resource "stackit_server" "example" {
+		name              = "%[1]s"
+		project_id        = "%[2]s"
+		machine_type      = "c1.2"
+		image_id          = "%[3]s"
+		availability_zone = "eu01-1"
+		network_id        = stackit_network.example.id
+	}
+
+	resource "stackit_volume" "example" {
+		name              = "%[1]s"
+		project_id        = "%[2]s"
+		availability_zone = "eu01-1"
+		size              = 10
+	}
+
+	resource "stackit_server_volume_attachment" "example" {
+		project_id = "%[2]s"
+		server_id  = stackit_server.example.id
+		volume_id  = stackit_volume.example.id
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		image,
+	)
+}
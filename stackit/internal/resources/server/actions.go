@@ -0,0 +1,334 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Server
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := toCreateRequest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	res, err := r.iaas.CreateServer(ctx, projectID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed creating server %s", body.Name), agg.Error())
+		return
+	}
+
+	// set the ID right away to keep track of the server if waiting fails
+	plan.ID = types.StringValue(res.Result.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), plan.ProjectID)...)
+
+	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	wr, err := r.iaas.ServerWaitHandler(ctx, projectID, res.Result.ID, iaas.ServerStatusActive).SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed validating server %s creation", body.Name), err.Error())
+		return
+	}
+
+	s, ok := wr.(iaas.Server)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas.Server")
+		return
+	}
+
+	toState(s, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func toCreateRequest(ctx context.Context, plan Server) (iaas.CreateServerRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	body := iaas.CreateServerRequest{
+		Name:             plan.Name.ValueString(),
+		MachineType:      plan.MachineType.ValueString(),
+		AvailabilityZone: stringPointer(plan.AvailabilityZone),
+		ImageID:          stringPointer(plan.ImageID),
+		KeypairName:      stringPointer(plan.KeypairName),
+		Networking:       &iaas.CreateServerNetwork{},
+	}
+
+	if bv := plan.BootVolume; bv != nil {
+		body.BootVolume = &iaas.ServerBootVolume{
+			PerformanceClass: stringPointer(bv.PerformanceClass),
+		}
+		if !bv.Size.IsNull() && !bv.Size.IsUnknown() {
+			size := bv.Size.ValueInt64()
+			body.BootVolume.Size = &size
+		}
+		if !bv.DeleteOnTermination.IsNull() && !bv.DeleteOnTermination.IsUnknown() {
+			del := bv.DeleteOnTermination.ValueBool()
+			body.BootVolume.DeleteOnTermination = &del
+		}
+		if !bv.SourceID.IsNull() && !bv.SourceID.IsUnknown() {
+			body.BootVolume.Source = &iaas.VolumeSource{
+				Type: bv.SourceType.ValueString(),
+				ID:   bv.SourceID.ValueString(),
+			}
+		}
+	}
+
+	if body.ImageID == nil && (body.BootVolume == nil || body.BootVolume.Source == nil) {
+		diags.AddError("missing boot source", "either `image_id` or `boot_volume.source_id` must be set")
+		return body, diags
+	}
+
+	if !plan.NetworkID.IsNull() && !plan.NetworkID.IsUnknown() {
+		body.Networking.NetworkID = stringPointer(plan.NetworkID)
+	} else {
+		nics := []string{}
+		diags.Append(plan.NetworkInterfaceIDs.ElementsAs(ctx, &nics, false)...)
+		body.Networking.NicIDs = &nics
+	}
+
+	if v := plan.UserData.ValueString(); v != "" {
+		ud := base64.StdEncoding.EncodeToString([]byte(v))
+		body.UserData = &ud
+	}
+
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+	return body, diags
+}
+
+func stringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+func toState(s iaas.Server, state *Server) {
+	state.ID = types.StringValue(s.ID)
+	state.Name = types.StringValue(s.Name)
+	state.MachineType = types.StringValue(s.MachineType)
+	state.Status = types.StringValue(s.Status)
+	state.AvailabilityZone = types.StringNull()
+	if s.AvailabilityZone != "" {
+		state.AvailabilityZone = types.StringValue(s.AvailabilityZone)
+	}
+	if s.ImageID != nil && !state.ImageID.IsNull() {
+		state.ImageID = types.StringValue(*s.ImageID)
+	}
+	if s.KeypairName != nil {
+		state.KeypairName = types.StringValue(*s.KeypairName)
+	}
+
+	state.Labels = nil
+	if s.Labels != nil && len(*s.Labels) > 0 {
+		state.Labels = *s.Labels
+	}
+
+	if state.BootVolume != nil {
+		state.BootVolume.ID = types.StringNull()
+		if s.BootVolume != nil && s.BootVolume.ID != nil {
+			state.BootVolume.ID = types.StringValue(*s.BootVolume.ID)
+		}
+	}
+
+	nics := []attr.Value{}
+	if s.NICs != nil {
+		for _, n := range *s.NICs {
+			nics = append(nics, types.ObjectValueMust(networkInterfaceType, map[string]attr.Value{
+				"nic_id":     types.StringValue(n.NicID),
+				"network_id": types.StringValue(n.NetworkID),
+				"ipv4":       types.StringPointerValue(n.IPv4),
+				"ipv6":       types.StringPointerValue(n.IPv6),
+				"mac":        types.StringPointerValue(n.Mac),
+			}))
+		}
+
+		// imported servers
+		if state.NetworkID.IsNull() && state.NetworkInterfaceIDs.IsNull() && len(*s.NICs) > 0 {
+			state.NetworkID = types.StringValue((*s.NICs)[0].NetworkID)
+		}
+	}
+	state.NetworkInterfaces = types.ListValueMust(types.ObjectType{AttrTypes: networkInterfaceType}, nics)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Server
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetServer(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading server", agg.Error())
+		return
+	}
+
+	if state.NetworkInterfaceIDs.IsUnknown() {
+		state.NetworkInterfaceIDs = types.ListNull(types.StringType)
+	}
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Server
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	serverID := state.ID.ValueString()
+
+	timeout, d := plan.Timeouts.Update(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.MachineType.Equal(state.MachineType) {
+		res, err := r.iaas.ResizeServer(ctx, projectID, serverID, iaas.ResizeServerRequest{
+			MachineType: plan.MachineType.ValueString(),
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed resizing server", agg.Error())
+			return
+		}
+	}
+
+	if !plan.Name.Equal(state.Name) || !labelsEqual(plan.Labels, state.Labels) {
+		name := plan.Name.ValueString()
+		labels := plan.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		res, err := r.iaas.UpdateServer(ctx, projectID, serverID, iaas.UpdateServerRequest{
+			Name:   &name,
+			Labels: &labels,
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed updating server", agg.Error())
+			return
+		}
+	}
+
+	wr, err := r.iaas.ServerWaitHandler(ctx, projectID, serverID, iaas.ServerStatusActive).SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating server update", err.Error())
+		return
+	}
+
+	s, ok := wr.(iaas.Server)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas.Server")
+		return
+	}
+
+	plan.ID = state.ID
+	toState(s, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Server
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	serverID := state.ID.ValueString()
+
+	res, err := r.iaas.DeleteServer(ctx, projectID, serverID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting server", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.iaas.ServerDeleteWaitHandler(ctx, projectID, serverID).SetTimeout(timeout).WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify server deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,server_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToStateAvailabilityZone(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name string
+		zone string
+		want types.String
+	}{
+		{"returned by the API", "eu01-1", types.StringValue("eu01-1")},
+		{"not returned by the API", "", types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := Server{AvailabilityZone: types.StringUnknown()}
+			toState(iaas.Server{ID: "id", AvailabilityZone: tt.zone}, &state)
+			if !state.AvailabilityZone.Equal(tt.want) {
+				t.Errorf("availability_zone = %v, want %v", state.AvailabilityZone, tt.want)
+			}
+		})
+	}
+}
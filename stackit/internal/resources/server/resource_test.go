@@ -0,0 +1,121 @@
+package server_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_Server(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	image, ok := os.LookupEnv("ACC_TEST_IMAGE_ID")
+	if !ok {
+		t.Skip("Skipping TestAcc_Server: ACC_TEST_IMAGE_ID not specified")
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, image, "c1.2", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_server.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_server.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_server.example", "machine_type", "c1.2"),
+					resource.TestCheckResourceAttr("stackit_server.example", "image_id", image),
+					resource.TestCheckResourceAttr("stackit_server.example", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr("stackit_server.example", "labels.key", "a"),
+					resource.TestCheckResourceAttr("stackit_server.example", "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttrPair("stackit_server.example", "network_id", "stackit_network.example", "id"),
+					resource.TestCheckResourceAttrPair("stackit_server.example", "keypair_name", "stackit_keypair.example", "name"),
+					resource.TestCheckResourceAttrSet("stackit_server.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_server.example", "availability_zone"),
+				),
+			},
+			// resize and update labels
+			{
+				Config: config(name, image, "c1.3", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_server.example", "machine_type", "c1.3"),
+					resource.TestCheckResourceAttr("stackit_server.example", "labels.key", "b"),
+					resource.TestCheckResourceAttr("stackit_server.example", "status", "ACTIVE"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_server.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_server.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_server.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_data", "timeouts"},
+			},
+		},
+	})
+}
+
+func config(name, image, machineType, label string) string {
+	return fmt.Sprintf(`
+	resource "stackit_network" "example" {
+		name       = "%[1]s"
+		project_id = "%[2]s"
+	}
+
+	resource "stackit_keypair" "example" {
+		name       = "%[1]s"
+		public_key = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEzqMiAbNwZbyxR6ABbkTnPXWpjo0OUDnTbUTTXBIu4c"
+	}
+
+	resource "stackit_server" "example" {
+		name         = "%[1]s"
+		project_id   = "%[2]s"
+		machine_type = "%[4]s"
+		image_id     = "%[3]s"
+		network_id   = stackit_network.example.id
+		keypair_name = stackit_keypair.example.name
+		user_data    = <<-EOT
+		#cloud-config
+		package_update: true
+		EOT
+		labels = {
+			"key" = "%[5]s"
+		}
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		image,
+		machineType,
+		label,
+	)
+}
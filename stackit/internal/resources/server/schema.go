@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Server is the schema model
+type Server struct {
+	ID                  types.String      `tfsdk:"id"`
+	ProjectID           types.String      `tfsdk:"project_id"`
+	Name                types.String      `tfsdk:"name"`
+	MachineType         types.String      `tfsdk:"machine_type"`
+	AvailabilityZone    types.String      `tfsdk:"availability_zone"`
+	ImageID             types.String      `tfsdk:"image_id"`
+	BootVolume          *BootVolume       `tfsdk:"boot_volume"`
+	NetworkID           types.String      `tfsdk:"network_id"`
+	NetworkInterfaceIDs types.List        `tfsdk:"network_interface_ids"`
+	NetworkInterfaces   types.List        `tfsdk:"network_interfaces"`
+	KeypairName         types.String      `tfsdk:"keypair_name"`
+	UserData            types.String      `tfsdk:"user_data"`
+	Labels              map[string]string `tfsdk:"labels"`
+	Status              types.String      `tfsdk:"status"`
+	Timeouts            timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// BootVolume is the boot volume model
+type BootVolume struct {
+	ID                  types.String `tfsdk:"id"`
+	Size                types.Int64  `tfsdk:"size"`
+	PerformanceClass    types.String `tfsdk:"performance_class"`
+	SourceType          types.String `tfsdk:"source_type"`
+	SourceID            types.String `tfsdk:"source_id"`
+	DeleteOnTermination types.Bool   `tfsdk:"delete_on_termination"`
+}
+
+var networkInterfaceType = map[string]attr.Type{
+	"nic_id":     types.StringType,
+	"network_id": types.StringType,
+	"ipv4":       types.StringType,
+	"ipv6":       types.StringType,
+	"mac":        types.StringType,
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT server\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the server ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "the server name",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "the machine type (flavor) of the server, for example `c1.2`. Changing this value resizes the server.",
+				Required:    true,
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "the availability zone of the server, for example `eu01-1`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				Description: "the image the server is booted from. Either `image_id` or `boot_volume.source_id` must be set. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					stringvalidator.ConflictsWith(path.MatchRoot("boot_volume").AtName("source_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"boot_volume": schema.SingleNestedAttribute{
+				Description: "the boot volume of the server. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "the boot volume ID",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"size": schema.Int64Attribute{
+						Description: "the boot volume size in GB",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"performance_class": schema.StringAttribute{
+						Description: "the performance class of the boot volume, for example `storage_premium_perf1`",
+						Optional:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"source_type": schema.StringAttribute{
+						Description: "the boot volume source type. Options: `image`, `volume`, `snapshot`, `backup`",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("image", "volume", "snapshot", "backup"),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("source_id")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"source_id": schema.StringAttribute{
+						Description: "the ID of the boot volume source",
+						Optional:    true,
+						Validators: []validator.String{
+							validate.UUID(),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("source_type")),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"delete_on_termination": schema.BoolAttribute{
+						Description: "specifies if the boot volume is deleted together with the server",
+						Optional:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "the network the server is attached to. Either `network_id` or `network_interface_ids` must be set. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("network_interface_ids")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interface_ids": schema.ListAttribute{
+				Description: "the IDs of existing network interfaces the server is attached to. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_interfaces": schema.ListNestedAttribute{
+				Description: "the network interfaces of the server",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"nic_id": schema.StringAttribute{
+							Description: "the network interface ID",
+							Computed:    true,
+						},
+						"network_id": schema.StringAttribute{
+							Description: "the network ID",
+							Computed:    true,
+						},
+						"ipv4": schema.StringAttribute{
+							Description: "the IPv4 address",
+							Computed:    true,
+						},
+						"ipv6": schema.StringAttribute{
+							Description: "the IPv6 address",
+							Computed:    true,
+						},
+						"mac": schema.StringAttribute{
+							Description: "the MAC address",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"keypair_name": schema.StringAttribute{
+				Description: "the name of the key pair used for SSH access. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_data": schema.StringAttribute{
+				Description: "user data (e.g. cloud-init) passed to the server. The value is base64 encoded by the provider. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "labels of the server",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "the server status",
+				Computed:    true,
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ServiceAccount
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ServiceAccounts.Create(ctx, plan.ProjectID.ValueString(), serviceaccounts.CreateJSONRequestBody{
+		Name: plan.Name.ValueString(),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating service account", agg.Error())
+		return
+	}
+
+	sa := res.JSON201
+	plan.ID = types.StringValue(string(sa.Email))
+	plan.Email = types.StringValue(string(sa.Email))
+	plan.ServiceAccountID = types.StringValue(sa.ID.String())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ServiceAccounts.Get(ctx, state.ProjectID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed listing service accounts", agg.Error())
+		return
+	}
+
+	for _, sa := range res.JSON200.Items {
+		if string(sa.Email) != state.ID.ValueString() {
+			continue
+		}
+		state.Email = types.StringValue(string(sa.Email))
+		state.ServiceAccountID = types.StringValue(sa.ID.String())
+		if state.Name.IsNull() || state.Name.IsUnknown() {
+			state.Name = types.StringValue(nameFromEmail(string(sa.Email)))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update - lifecycle function - not used for this resource
+func (r Resource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ServiceAccount
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.ServiceAccounts.Delete(ctx, state.ProjectID.ValueString(), openapiTypes.Email(state.ID.ValueString()))
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting service account", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,email`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	// validate project id
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), nameFromEmail(idParts[1]))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// nameFromEmail returns the name prefix of a generated service account email
+// i.e. `my-sa-a1b2c3@sa.stackit.cloud` results in `my-sa`
+func nameFromEmail(email string) string {
+	local, _, _ := strings.Cut(email, "@")
+	if i := strings.LastIndex(local, "-"); i > 0 {
+		return local[:i]
+	}
+	return local
+}
@@ -0,0 +1,70 @@
+package account_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_ServiceAccount(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	name = strings.ToLower(name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_service_account.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_service_account.example", "name", name),
+					resource.TestCheckResourceAttrSet("stackit_service_account.example", "email"),
+					resource.TestCheckResourceAttrSet("stackit_service_account.example", "service_account_id"),
+					resource.TestCheckResourceAttrPair("stackit_service_account.example", "id", "stackit_service_account.example", "email"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_service_account.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_service_account.example"]
+					if !ok {
+						return "", fmt.Errorf("couldn't find resource stackit_service_account.example")
+					}
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), r.Primary.ID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_service_account" "example" {
+		project_id = "%s"
+		name       = "%s"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -0,0 +1,129 @@
+package key
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Key
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := serviceaccounts.CreateKeysJSONRequestBody{}
+	if !plan.PublicKey.IsNull() && !plan.PublicKey.IsUnknown() {
+		pk := plan.PublicKey.ValueString()
+		body.PublicKey = &pk
+	}
+	if !plan.ValidUntil.IsNull() && !plan.ValidUntil.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, plan.ValidUntil.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("couldn't parse valid_until", err.Error())
+			return
+		}
+		body.ValidUntil = &t
+	}
+
+	email := openapiTypes.Email(plan.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.CreateKeys(ctx, plan.ProjectID.ValueString(), email, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating service account key", agg.Error())
+		return
+	}
+
+	k := res.JSON201
+	b, err := json.Marshal(k)
+	if err != nil {
+		resp.Diagnostics.AddError("failed encoding service account key", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(k.ID.String())
+	plan.PublicKey = types.StringValue(k.PublicKey)
+	plan.Active = types.BoolValue(k.Active)
+	plan.KeyType = types.StringValue(string(k.KeyType))
+	plan.KeyOrigin = types.StringValue(string(k.KeyOrigin))
+	plan.KeyAlgorithm = types.StringValue(string(k.KeyAlgorithm))
+	plan.CreatedAt = types.StringValue(k.CreatedAt.Format(time.RFC3339))
+	plan.JSON = types.StringValue(string(b))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Key
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := openapiTypes.Email(state.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.GetKeys(ctx, state.ProjectID.ValueString(), email)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed listing service account keys", agg.Error())
+		return
+	}
+
+	for _, k := range res.JSON200.Items {
+		if k.ID.String() != state.ID.ValueString() {
+			continue
+		}
+		state.Active = types.BoolValue(k.Active)
+		state.KeyType = types.StringValue(string(k.KeyType))
+		state.KeyOrigin = types.StringValue(string(k.KeyOrigin))
+		state.KeyAlgorithm = types.StringValue(string(k.KeyAlgorithm))
+		state.CreatedAt = types.StringValue(k.CreatedAt.Format(time.RFC3339))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update - lifecycle function - not used for this resource
+func (r Resource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Key
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed parsing key ID", err.Error())
+		return
+	}
+
+	email := openapiTypes.Email(state.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.DeleteKeys(ctx, state.ProjectID.ValueString(), email, id)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting service account key", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
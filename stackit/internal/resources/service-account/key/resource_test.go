@@ -0,0 +1,63 @@
+package key_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const run_this_test = false
+
+func TestAcc_ServiceAccountKey(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "acc-" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	name = strings.ToLower(name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("stackit_service_account.example", "email", "stackit_service_account_key.example", "service_account_email"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_key.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_key.example", "json"),
+					resource.TestCheckResourceAttrSet("stackit_service_account_key.example", "public_key"),
+					resource.TestCheckResourceAttr("stackit_service_account_key.example", "key_origin", "GENERATED"),
+					resource.TestCheckResourceAttr("stackit_service_account_key.example", "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func config(name string) string {
+	return fmt.Sprintf(`
+	resource "stackit_service_account" "example" {
+		project_id = "%s"
+		name       = "%s"
+	}
+
+	resource "stackit_service_account_key" "example" {
+		project_id            = stackit_service_account.example.project_id
+		service_account_email = stackit_service_account.example.email
+		valid_until           = "2099-01-01T00:00:00Z"
+	}
+	`,
+		common.GetAcceptanceTestsProjectID(),
+		name,
+	)
+}
@@ -0,0 +1,130 @@
+package key
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Key is the schema model
+type Key struct {
+	ID                  types.String `tfsdk:"id"`
+	ProjectID           types.String `tfsdk:"project_id"`
+	ServiceAccountEmail types.String `tfsdk:"service_account_email"`
+	PublicKey           types.String `tfsdk:"public_key"`
+	ValidUntil          types.String `tfsdk:"valid_until"`
+	Active              types.Bool   `tfsdk:"active"`
+	KeyType             types.String `tfsdk:"key_type"`
+	KeyOrigin           types.String `tfsdk:"key_origin"`
+	KeyAlgorithm        types.String `tfsdk:"key_algorithm"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	JSON                types.String `tfsdk:"json"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT service account key. The resulting `json` can be used as service account key for the key flow authentication\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the key UUID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_account_email": schema.StringAttribute{
+				Description: "The email of the service account the key belongs to. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Description: "Optional public key of a user generated RSA 2048 key-pair wrapped in a X.509 v3 certificate (PEM). If not specified, the key-pair is generated by STACKIT and the private key is included in `json`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"valid_until": schema.StringAttribute{
+				Description: "Optional RFC3339 timestamp until which the key is valid, for example `2024-01-01T00:00:00Z`. When omitted, the key is valid until deleted. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.StringWith(validateRFC3339, "validate valid_until is RFC3339 compatible"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "specifies if the key is active",
+				Computed:    true,
+			},
+			"key_type": schema.StringAttribute{
+				Description: "the key type",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_origin": schema.StringAttribute{
+				Description: "the key origin, either `GENERATED` or `USER_PROVIDED`",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				Description: "the key algorithm",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "the key creation time",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"json": schema.StringAttribute{
+				Description: "the service account key JSON (sensitive). Can only be retrieved on creation.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func validateRFC3339(s string) error {
+	_, err := time.Parse(time.RFC3339, s)
+	return err
+}
@@ -0,0 +1,107 @@
+package token
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	openapiTypes "github.com/SchwarzIT/community-stackit-go-client/pkg/helpers/types"
+	serviceaccounts "github.com/SchwarzIT/community-stackit-go-client/pkg/services/service-accounts/v2.0"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Token
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := openapiTypes.Email(plan.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.CreateAccessTokens(ctx, plan.ProjectID.ValueString(), email, serviceaccounts.CreateAccessTokensJSONRequestBody{
+		TtlDays: int(plan.TTLDays.ValueInt64()),
+	})
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON201"); agg != nil {
+		resp.Diagnostics.AddError("failed creating service account access token", agg.Error())
+		return
+	}
+
+	t := res.JSON201
+	plan.ID = types.StringValue(t.ID.String())
+	plan.Active = types.BoolValue(t.Active)
+	plan.CreatedAt = types.StringValue(t.CreatedAt.Format(time.RFC3339))
+	plan.ValidUntil = types.StringValue(t.ValidUntil.Format(time.RFC3339))
+	plan.Token = types.StringValue(t.Token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Token
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := openapiTypes.Email(state.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.GetAccessTokens(ctx, state.ProjectID.ValueString(), email)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "JSON200"); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed listing service account access tokens", agg.Error())
+		return
+	}
+
+	if res.JSON200.Items != nil {
+		for _, t := range *res.JSON200.Items {
+			if t.ID.String() != state.ID.ValueString() {
+				continue
+			}
+			state.Active = types.BoolValue(t.Active)
+			state.CreatedAt = types.StringValue(t.CreatedAt.Format(time.RFC3339))
+			state.ValidUntil = types.StringValue(t.ValidUntil.Format(time.RFC3339))
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// Update - lifecycle function - not used for this resource
+func (r Resource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Token
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed parsing token ID", err.Error())
+		return
+	}
+
+	email := openapiTypes.Email(state.ServiceAccountEmail.ValueString())
+	res, err := r.client.ServiceAccounts.DeleteAccessTokens(ctx, state.ProjectID.ValueString(), email, id)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if res != nil && res.StatusCode() == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting service account access token", agg.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
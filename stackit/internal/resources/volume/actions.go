@@ -0,0 +1,270 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/iaas"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Create - lifecycle function
+func (r Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Volume
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := iaas.CreateVolumeRequest{
+		Name:             stringPointer(plan.Name),
+		Description:      stringPointer(plan.Description),
+		AvailabilityZone: plan.AvailabilityZone.ValueString(),
+		PerformanceClass: stringPointer(plan.PerformanceClass),
+	}
+	if !plan.Size.IsNull() && !plan.Size.IsUnknown() {
+		size := plan.Size.ValueInt64()
+		body.Size = &size
+	}
+	if !plan.SourceID.IsNull() {
+		body.Source = &iaas.VolumeSource{
+			Type: plan.SourceType.ValueString(),
+			ID:   plan.SourceID.ValueString(),
+		}
+	}
+	if plan.Labels != nil {
+		labels := plan.Labels
+		body.Labels = &labels
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	res, err := r.iaas.CreateVolume(ctx, projectID, body)
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		resp.Diagnostics.AddError("failed creating volume", agg.Error())
+		return
+	}
+
+	// set the ID right away to keep track of the volume if waiting fails
+	plan.ID = types.StringValue(res.Result.ID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), plan.ProjectID)...)
+
+	timeout, d := plan.Timeouts.Create(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	wr, err := r.iaas.VolumeWaitHandler(ctx, projectID, res.Result.ID, iaas.VolumeStatusAvailable).SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating volume creation", err.Error())
+		return
+	}
+
+	v, ok := wr.(iaas.Volume)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas.Volume")
+		return
+	}
+
+	toState(v, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func stringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// nullIfEmpty keeps unset optional attributes null
+// since clearing them is done by sending an empty string
+func nullIfEmpty(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+func toState(v iaas.Volume, state *Volume) {
+	state.ID = types.StringValue(v.ID)
+	state.AvailabilityZone = types.StringValue(v.AvailabilityZone)
+	state.Name = nullIfEmpty(v.Name)
+	state.Description = nullIfEmpty(v.Description)
+	state.Size = types.Int64PointerValue(v.Size)
+	state.PerformanceClass = types.StringPointerValue(v.PerformanceClass)
+	state.ServerID = types.StringPointerValue(v.ServerID)
+	state.Status = types.StringValue(v.Status)
+	if v.Source != nil && !state.SourceID.IsNull() {
+		state.SourceType = types.StringValue(v.Source.Type)
+		state.SourceID = types.StringValue(v.Source.ID)
+	}
+
+	state.Labels = nil
+	if v.Labels != nil && len(*v.Labels) > 0 {
+		state.Labels = *v.Labels
+	}
+}
+
+// Read - lifecycle function
+func (r Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Volume
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.iaas.GetVolume(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if agg := common.Validate(&resp.Diagnostics, res, err, "Result"); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed reading volume", agg.Error())
+		return
+	}
+
+	toState(*res.Result, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update - lifecycle function
+func (r Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Volume
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	volumeID := state.ID.ValueString()
+
+	timeout, d := plan.Timeouts.Update(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Size.IsUnknown() && !plan.Size.Equal(state.Size) {
+		res, err := r.iaas.ResizeVolume(ctx, projectID, volumeID, iaas.ResizeVolumeRequest{
+			Size: plan.Size.ValueInt64(),
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed resizing volume", agg.Error())
+			return
+		}
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) || !labelsEqual(plan.Labels, state.Labels) {
+		name := plan.Name.ValueString()
+		description := plan.Description.ValueString()
+		labels := plan.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		res, err := r.iaas.UpdateVolume(ctx, projectID, volumeID, iaas.UpdateVolumeRequest{
+			Name:        &name,
+			Description: &description,
+			Labels:      &labels,
+		})
+		if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+			resp.Diagnostics.AddError("failed updating volume", agg.Error())
+			return
+		}
+	}
+
+	// resizing is done async, attached volumes return to ATTACHED
+	wr, err := r.iaas.VolumeWaitHandler(ctx, projectID, volumeID, iaas.VolumeStatusAvailable, iaas.VolumeStatusAttached).SetTimeout(timeout).WaitWithContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed validating volume update", err.Error())
+		return
+	}
+
+	v, ok := wr.(iaas.Volume)
+	if !ok {
+		resp.Diagnostics.AddError("failed wait result conversion", "result is not of iaas.Volume")
+		return
+	}
+
+	toState(v, &plan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Delete - lifecycle function
+func (r Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Volume
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := state.ProjectID.ValueString()
+	volumeID := state.ID.ValueString()
+
+	res, err := r.iaas.DeleteVolume(ctx, projectID, volumeID)
+	if agg := common.Validate(&resp.Diagnostics, res, err); agg != nil {
+		if clientValidate.StatusEquals(res, http.StatusNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed deleting volume", agg.Error())
+		return
+	}
+
+	timeout, d := state.Timeouts.Delete(ctx, 30*time.Minute)
+	if resp.Diagnostics.Append(d...); resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.iaas.VolumeDeleteWaitHandler(ctx, projectID, volumeID).SetTimeout(timeout).WaitWithContext(ctx); err != nil {
+		resp.Diagnostics.AddError("failed to verify volume deletion", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState handles terraform import
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: `project_id,volume_id`\nInstead got: %q", req.ID),
+		)
+		return
+	}
+
+	if err := clientValidate.ProjectID(idParts[0]); err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Couldn't validate project_id.\n%s", err.Error()),
+		)
+		return
+	}
+
+	// set main attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
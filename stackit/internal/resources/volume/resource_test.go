@@ -0,0 +1,89 @@
+package volume_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const run_this_test = false
+
+func TestAcc_Volume(t *testing.T) {
+	if !common.ShouldAccTestRun(run_this_test) {
+		t.Skip()
+		return
+	}
+
+	name := "odjtest-" + acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"stackit": providerserver.NewProtocol6WithError(stackit.New("test")()),
+		},
+		Steps: []resource.TestStep{
+			// check minimal configuration
+			{
+				Config: config(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_volume.example", "name", name),
+					resource.TestCheckResourceAttr("stackit_volume.example", "project_id", common.GetAcceptanceTestsProjectID()),
+					resource.TestCheckResourceAttr("stackit_volume.example", "availability_zone", "eu01-1"),
+					resource.TestCheckResourceAttr("stackit_volume.example", "size", "10"),
+					resource.TestCheckResourceAttr("stackit_volume.example", "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet("stackit_volume.example", "id"),
+					resource.TestCheckResourceAttrSet("stackit_volume.example", "performance_class"),
+				),
+			},
+			// resize
+			{
+				Config: config(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("stackit_volume.example", "size", "20"),
+					resource.TestCheckResourceAttr("stackit_volume.example", "status", "AVAILABLE"),
+				),
+			},
+			// test import
+			{
+				ResourceName: "stackit_volume.example",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r, ok := s.RootModule().Resources["stackit_volume.example"]
+					if !ok {
+						return "", errors.New("couldn't find resource stackit_volume.example")
+					}
+					id, ok := r.Primary.Attributes["id"]
+					if !ok {
+						return "", errors.New("couldn't find attribute id")
+					}
+
+					return fmt.Sprintf("%s,%s", common.GetAcceptanceTestsProjectID(), id), nil
+				},
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func config(name string, size int) string {
+	return fmt.Sprintf(`
+	resource "stackit_volume" "example" {
+		name              = "%s"
+		project_id        = "%s"
+		availability_zone = "eu01-1"
+		size              = %d
+	}
+	`,
+		name,
+		common.GetAcceptanceTestsProjectID(),
+		size,
+	)
+}
@@ -0,0 +1,150 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/pkg/validate"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Volume is the schema model
+type Volume struct {
+	ID               types.String      `tfsdk:"id"`
+	ProjectID        types.String      `tfsdk:"project_id"`
+	Name             types.String      `tfsdk:"name"`
+	Description      types.String      `tfsdk:"description"`
+	AvailabilityZone types.String      `tfsdk:"availability_zone"`
+	Size             types.Int64       `tfsdk:"size"`
+	PerformanceClass types.String      `tfsdk:"performance_class"`
+	SourceType       types.String      `tfsdk:"source_type"`
+	SourceID         types.String      `tfsdk:"source_id"`
+	Labels           map[string]string `tfsdk:"labels"`
+	ServerID         types.String      `tfsdk:"server_id"`
+	Status           types.String      `tfsdk:"status"`
+	Timeouts         timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// Schema returns the terraform schema structure
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages a STACKIT block storage volume\n%s",
+			common.EnvironmentInfo(r.urls),
+		),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "the volume ID",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "The project UUID. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.ProjectID(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "the volume name",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "the volume description",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(127),
+				},
+			},
+			"availability_zone": schema.StringAttribute{
+				Description: "the availability zone of the volume, for example `eu01-1`. Changing this value requires the resource to be recreated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "the volume size in GB. Either `size` or `source_id` must be set. The size can only be increased, decreasing it requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastOneOf(path.MatchRoot("source_id")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.PlanValue.IsUnknown() && !req.StateValue.IsNull() && req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+					}, "decreasing the volume size requires the resource to be recreated", "decreasing the volume size requires the resource to be recreated"),
+				},
+			},
+			"performance_class": schema.StringAttribute{
+				Description: "the performance class of the volume, for example `storage_premium_perf1`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Description: "the volume source type. Options: `image`, `volume`, `snapshot`, `backup`. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("image", "volume", "snapshot", "backup"),
+					stringvalidator.AlsoRequires(path.MatchRoot("source_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_id": schema.StringAttribute{
+				Description: "the ID of the volume source. Changing this value requires the resource to be recreated.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					stringvalidator.AlsoRequires(path.MatchRoot("source_type")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: "labels of the volume",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "the ID of the server the volume is attached to",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "the volume status",
+				Computed:    true,
+			},
+			"timeouts": common.Timeouts(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
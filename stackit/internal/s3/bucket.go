@@ -0,0 +1,139 @@
+package s3
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // required by the S3 API
+	"encoding/base64"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+)
+
+// Versioning status values
+const (
+	VersioningEnabled   = "Enabled"
+	VersioningSuspended = "Suspended"
+)
+
+// VersioningConfiguration is the bucket versioning configuration
+type VersioningConfiguration struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+// LifecycleConfiguration is the bucket lifecycle configuration
+type LifecycleConfiguration struct {
+	XMLName xml.Name        `xml:"LifecycleConfiguration"`
+	Rules   []LifecycleRule `xml:"Rule"`
+}
+
+// LifecycleRule is a single lifecycle rule
+type LifecycleRule struct {
+	ID                          string                       `xml:"ID,omitempty"`
+	Filter                      *LifecycleFilter             `xml:"Filter"`
+	Status                      string                       `xml:"Status"`
+	Expiration                  *Expiration                  `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration *NoncurrentVersionExpiration `xml:"NoncurrentVersionExpiration,omitempty"`
+}
+
+// LifecycleFilter selects the objects a lifecycle rule applies to
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// Expiration expires current object versions
+type Expiration struct {
+	Days int64 `xml:"Days"`
+}
+
+// NoncurrentVersionExpiration expires noncurrent object versions
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays int64 `xml:"NoncurrentDays"`
+}
+
+func subresource(name string) url.Values {
+	return url.Values{name: []string{""}}
+}
+
+// GetBucketPolicy returns the bucket policy JSON
+func (c *Client) GetBucketPolicy(ctx context.Context) (string, error) {
+	_, b, err := c.do(ctx, request{method: http.MethodGet, query: subresource("policy")})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// PutBucketPolicy sets the bucket policy JSON
+func (c *Client) PutBucketPolicy(ctx context.Context, policy string) error {
+	_, _, err := c.do(ctx, request{
+		method: http.MethodPut,
+		query:  subresource("policy"),
+		header: http.Header{"Content-Type": []string{"application/json"}},
+		body:   []byte(policy),
+	})
+	return err
+}
+
+// DeleteBucketPolicy removes the bucket policy
+func (c *Client) DeleteBucketPolicy(ctx context.Context) error {
+	_, _, err := c.do(ctx, request{method: http.MethodDelete, query: subresource("policy")})
+	return err
+}
+
+// GetBucketVersioning returns the versioning configuration
+// the status is empty if versioning was never enabled
+func (c *Client) GetBucketVersioning(ctx context.Context) (VersioningConfiguration, error) {
+	v := VersioningConfiguration{}
+	_, b, err := c.do(ctx, request{method: http.MethodGet, query: subresource("versioning")})
+	if err != nil {
+		return v, err
+	}
+	err = xml.Unmarshal(b, &v)
+	return v, err
+}
+
+// PutBucketVersioning sets the versioning status
+func (c *Client) PutBucketVersioning(ctx context.Context, status string) error {
+	return c.putXML(ctx, "versioning", VersioningConfiguration{Status: status})
+}
+
+// GetBucketLifecycle returns the lifecycle configuration
+func (c *Client) GetBucketLifecycle(ctx context.Context) (LifecycleConfiguration, error) {
+	l := LifecycleConfiguration{}
+	_, b, err := c.do(ctx, request{method: http.MethodGet, query: subresource("lifecycle")})
+	if err != nil {
+		return l, err
+	}
+	err = xml.Unmarshal(b, &l)
+	return l, err
+}
+
+// PutBucketLifecycle replaces the lifecycle configuration
+func (c *Client) PutBucketLifecycle(ctx context.Context, rules []LifecycleRule) error {
+	return c.putXML(ctx, "lifecycle", LifecycleConfiguration{Rules: rules})
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration
+func (c *Client) DeleteBucketLifecycle(ctx context.Context) error {
+	_, _, err := c.do(ctx, request{method: http.MethodDelete, query: subresource("lifecycle")})
+	return err
+}
+
+func (c *Client) putXML(ctx context.Context, sub string, v interface{}) error {
+	b, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	sum := md5.Sum(b) //nolint:gosec // required by the S3 API
+	_, _, err = c.do(ctx, request{
+		method: http.MethodPut,
+		query:  subresource(sub),
+		header: http.Header{
+			"Content-Type": []string{"application/xml"},
+			"Content-Md5":  []string{base64.StdEncoding.EncodeToString(sum[:])},
+		},
+		body: b,
+	})
+	return err
+}
@@ -0,0 +1,267 @@
+// Package s3 provides a minimal client for the S3 compatible API of STACKIT Object Storage
+// requests are signed using AWS signature version 4 with the access keys of a `stackit_object_storage_credential`
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultRegion is the region used for signing if none is specified
+const DefaultRegion = "eu01"
+
+// Client is an S3 client for a single bucket
+type Client struct {
+	endpoint        *url.URL
+	bucket          string
+	hostStyle       bool
+	region          string
+	accessKey       string
+	secretAccessKey string
+	httpClient      *http.Client
+	now             func() time.Time
+}
+
+// Error is an S3 error response
+type Error struct {
+	StatusCode int    `xml:"-"`
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("S3 request failed with status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("S3 request failed with status code %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound returns true if the error is an S3 404 error
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// New returns a new client for the bucket of the given URL
+// both path style URLs, for example https://object.storage.eu01.onstackit.cloud/my-bucket
+// and host style URLs, for example https://my-bucket.object.storage.eu01.onstackit.cloud are supported
+func New(bucketURL, region, accessKey, secretAccessKey string) (*Client, error) {
+	u, err := url.Parse(bucketURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing bucket URL: %w", err)
+	}
+	bucket := strings.Trim(u.Path, "/")
+	hostStyle := bucket == ""
+	if hostStyle {
+		bucket, _, _ = strings.Cut(u.Hostname(), ".")
+		if !strings.Contains(u.Hostname(), ".") {
+			bucket = ""
+		}
+	}
+	if u.Scheme == "" || u.Host == "" || bucket == "" || strings.Contains(bucket, "/") {
+		return nil, fmt.Errorf("invalid bucket URL %q, expected format: https://<host>/<bucket> or https://<bucket>.<host>", bucketURL)
+	}
+	if region == "" {
+		region = DefaultRegion
+	}
+	return &Client{
+		endpoint:        &url.URL{Scheme: u.Scheme, Host: u.Host},
+		bucket:          bucket,
+		hostStyle:       hostStyle,
+		region:          region,
+		accessKey:       accessKey,
+		secretAccessKey: secretAccessKey,
+		httpClient:      &http.Client{Timeout: 5 * time.Minute},
+		now:             time.Now,
+	}, nil
+}
+
+// Bucket returns the bucket name
+func (c *Client) Bucket() string {
+	return c.bucket
+}
+
+// request is a single S3 request
+type request struct {
+	method  string
+	key     string
+	query   url.Values
+	header  http.Header
+	body    []byte
+	success []int
+}
+
+func (c *Client) do(ctx context.Context, r request) (*http.Response, []byte, error) {
+	u := *c.endpoint
+	if !c.hostStyle {
+		u.Path = "/" + c.bucket
+	}
+	if r.key != "" {
+		u.Path += "/" + r.key
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	// object keys are encoded the same way they are signed
+	u.RawPath = escapePath(u.Path)
+	u.RawQuery = canonicalQuery(r.query)
+
+	req, err := http.NewRequestWithContext(ctx, r.method, u.String(), bytes.NewReader(r.body))
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range r.header {
+		req.Header[k] = v
+	}
+	req.ContentLength = int64(len(r.body))
+	c.sign(req, r.body)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	success := r.success
+	if len(success) == 0 {
+		success = []int{http.StatusOK, http.StatusNoContent}
+	}
+	for _, s := range success {
+		if res.StatusCode == s {
+			return res, b, nil
+		}
+	}
+
+	e := &Error{}
+	_ = xml.Unmarshal(b, e)
+	e.StatusCode = res.StatusCode
+	return res, b, e
+}
+
+// sign adds an AWS signature version 4 authorization header to the request
+func (c *Client) sign(req *http.Request, body []byte) {
+	t := c.now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	signature, signedHeaders := signature(req, payloadHash, amzDate, date, c.region, c.secretAccessKey)
+	scope := strings.Join([]string{date, c.region, "s3", "aws4_request"}, "/")
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature))
+}
+
+func signature(req *http.Request, payloadHash, amzDate, date, region, secret string) (string, string) {
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		lk := strings.ToLower(k)
+		if lk == "content-md5" || lk == "content-type" || lk == "range" || strings.HasPrefix(lk, "x-amz-") {
+			headers[lk] = strings.TrimSpace(strings.Join(v, ","))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := ""
+	for _, k := range names {
+		canonicalHeaders += k + ":" + headers[k] + "\n"
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign)), signedHeaders
+}
+
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := []string{}
+	for _, k := range keys {
+		values := q[k]
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, escape(k)+"="+escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escapePath URI encodes every path segment
+func escapePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		segments[i] = escape(s)
+	}
+	return strings.Join(segments, "/")
+}
+
+// escape URI encodes a string as required by signature version 4
+func escape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+func sha256Hex(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,191 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/s3/s3test"
+)
+
+func TestSignature(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	// example from the AWS signature version 4 documentation
+	req, _ := http.NewRequest(http.MethodGet, "https://examplebucket.s3.amazonaws.com/test.txt", nil)
+	req.Header.Set("Range", "bytes=0-9")
+	req.Header.Set("x-amz-content-sha256", sha256Hex(nil))
+	req.Header.Set("x-amz-date", "20130524T000000Z")
+
+	got, headers := signature(req, sha256Hex(nil), "20130524T000000Z", "20130524", "us-east-1", "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY")
+	if want := "f0e8bdb87c964420e857bd35b5d6ed310bd44f0170aba48dd91039c6036bdb41"; got != want {
+		t.Errorf("signature() = %s, want %s", got, want)
+	}
+	if want := "host;range;x-amz-content-sha256;x-amz-date"; headers != want {
+		t.Errorf("signed headers = %s, want %s", headers, want)
+	}
+}
+
+func TestNew(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	tests := []struct {
+		name    string
+		url     string
+		bucket  string
+		wantErr bool
+	}{
+		{"path style", "https://object.storage.eu01.onstackit.cloud/my-bucket", "my-bucket", false},
+		{"trailing slash", "https://object.storage.eu01.onstackit.cloud/my-bucket/", "my-bucket", false},
+		{"host style", "https://my-bucket.object.storage.eu01.onstackit.cloud", "my-bucket", false},
+		{"no bucket", "https://localhost", "", true},
+		{"nested path", "https://object.storage.eu01.onstackit.cloud/a/b", "", true},
+		{"no scheme", "object.storage.eu01.onstackit.cloud/my-bucket", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := New(tt.url, "", "access", "secret")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && c.Bucket() != tt.bucket {
+				t.Errorf("Bucket() = %s, want %s", c.Bucket(), tt.bucket)
+			}
+		})
+	}
+}
+
+func TestBucketConfiguration(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := s3test.NewServer(t, "my-bucket")
+	c, err := New(srv.BucketURL(), "", s3test.AccessKey, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.now = func() time.Time { return time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	// policy
+	if _, err := c.GetBucketPolicy(ctx); !IsNotFound(err) {
+		t.Fatalf("GetBucketPolicy() expected not found, got %v", err)
+	}
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	if err := c.PutBucketPolicy(ctx, policy); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.GetBucketPolicy(ctx); err != nil || got != policy {
+		t.Fatalf("GetBucketPolicy() = %s, %v", got, err)
+	}
+	if err := c.DeleteBucketPolicy(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// versioning
+	if v, err := c.GetBucketVersioning(ctx); err != nil || v.Status != "" {
+		t.Fatalf("GetBucketVersioning() = %v, %v", v, err)
+	}
+	if err := c.PutBucketVersioning(ctx, VersioningEnabled); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := c.GetBucketVersioning(ctx); err != nil || v.Status != VersioningEnabled {
+		t.Fatalf("GetBucketVersioning() = %v, %v", v, err)
+	}
+
+	// lifecycle
+	rules := []LifecycleRule{{
+		ID:                          "logs",
+		Filter:                      &LifecycleFilter{Prefix: "logs/"},
+		Status:                      "Enabled",
+		Expiration:                  &Expiration{Days: 30},
+		NoncurrentVersionExpiration: &NoncurrentVersionExpiration{NoncurrentDays: 7},
+	}}
+	if err := c.PutBucketLifecycle(ctx, rules); err != nil {
+		t.Fatal(err)
+	}
+	l, err := c.GetBucketLifecycle(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l.Rules) != 1 || l.Rules[0].Filter.Prefix != "logs/" || l.Rules[0].Expiration.Days != 30 || l.Rules[0].NoncurrentVersionExpiration.NoncurrentDays != 7 {
+		t.Fatalf("GetBucketLifecycle() = %+v", l)
+	}
+	if err := c.DeleteBucketLifecycle(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetBucketLifecycle(ctx); !IsNotFound(err) {
+		t.Fatalf("GetBucketLifecycle() expected not found, got %v", err)
+	}
+
+	// wrong credentials
+	c.accessKey = "other"
+	if err := c.PutBucketVersioning(ctx, VersioningSuspended); err == nil || IsNotFound(err) {
+		t.Fatalf("expected access denied, got %v", err)
+	}
+}
+
+func TestObjects(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := s3test.NewServer(t, "my-bucket")
+	c, err := New(srv.BucketURL(), "", s3test.AccessKey, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := "site/index page+1.html"
+	if _, err := c.HeadObject(ctx, key); !IsNotFound(err) {
+		t.Fatalf("HeadObject() expected not found, got %v", err)
+	}
+
+	etag, err := c.PutObject(ctx, key, []byte("hello"), "text/html", map[string]string{"owner": "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// md5 of "hello"
+	if want := "5d41402abc4b2a76b9719d911017c592"; etag != want {
+		t.Errorf("PutObject() = %s, want %s", etag, want)
+	}
+
+	o, err := c.GetObject(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(o.Body) != "hello" || o.ETag != etag || o.ContentType != "text/html" || o.ContentLength != 5 || o.Metadata["owner"] != "team" || o.LastModified.IsZero() {
+		t.Errorf("GetObject() = %+v", o)
+	}
+
+	h, err := c.HeadObject(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Body != nil || h.ETag != etag || h.ContentLength != 5 {
+		t.Errorf("HeadObject() = %+v", h)
+	}
+
+	if err := c.DeleteObject(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := srv.Object(key); ok {
+		t.Error("expected object to be deleted")
+	}
+}
@@ -0,0 +1,92 @@
+package s3
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const metadataPrefix = "X-Amz-Meta-"
+
+// Object is an object stored in the bucket
+type Object struct {
+	Key           string
+	ETag          string
+	ContentType   string
+	ContentLength int64
+	LastModified  time.Time
+	Metadata      map[string]string
+	// Body is only set by GetObject
+	Body []byte
+}
+
+// PutObject uploads an object and returns its ETag
+// metadata keys are sent as `x-amz-meta-<key>` headers
+func (c *Client) PutObject(ctx context.Context, key string, body []byte, contentType string, metadata map[string]string) (string, error) {
+	header := http.Header{}
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	for k, v := range metadata {
+		header.Set(metadataPrefix+k, v)
+	}
+	res, _, err := c.do(ctx, request{
+		method: http.MethodPut,
+		key:    key,
+		header: header,
+		body:   body,
+	})
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(res.Header.Get("ETag"), `"`), nil
+}
+
+// HeadObject returns the object's attributes without the body
+func (c *Client) HeadObject(ctx context.Context, key string) (Object, error) {
+	res, _, err := c.do(ctx, request{method: http.MethodHead, key: key})
+	if err != nil {
+		return Object{}, err
+	}
+	return toObject(key, res), nil
+}
+
+// GetObject downloads an object
+func (c *Client) GetObject(ctx context.Context, key string) (Object, error) {
+	res, b, err := c.do(ctx, request{method: http.MethodGet, key: key})
+	if err != nil {
+		return Object{}, err
+	}
+	o := toObject(key, res)
+	o.Body = b
+	return o, nil
+}
+
+// DeleteObject deletes an object
+func (c *Client) DeleteObject(ctx context.Context, key string) error {
+	_, _, err := c.do(ctx, request{method: http.MethodDelete, key: key})
+	return err
+}
+
+func toObject(key string, res *http.Response) Object {
+	o := Object{
+		Key:         key,
+		ETag:        strings.Trim(res.Header.Get("ETag"), `"`),
+		ContentType: res.Header.Get("Content-Type"),
+		Metadata:    map[string]string{},
+	}
+	if l, err := strconv.ParseInt(res.Header.Get("Content-Length"), 10, 64); err == nil {
+		o.ContentLength = l
+	}
+	if t, err := http.ParseTime(res.Header.Get("Last-Modified")); err == nil {
+		o.LastModified = t
+	}
+	for k, v := range res.Header {
+		if strings.HasPrefix(k, metadataPrefix) && len(v) > 0 {
+			o.Metadata[strings.ToLower(strings.TrimPrefix(k, metadataPrefix))] = v[0]
+		}
+	}
+	return o
+}
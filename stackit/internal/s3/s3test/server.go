@@ -0,0 +1,174 @@
+// Package s3test provides a local in-memory stand-in for the S3 API to be used in unit tests
+package s3test
+
+import (
+	"crypto/md5" //nolint:gosec // ETag of the S3 API
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// AccessKey is the only access key accepted by the server
+const AccessKey = "access"
+
+// Server is an in-memory S3 server for a single bucket
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	bucket      string
+	subresource map[string][]byte
+	objects     map[string]object
+}
+
+type object struct {
+	body         []byte
+	header       http.Header
+	lastModified time.Time
+}
+
+// NewServer starts a new server which is closed when the test finishes
+func NewServer(t *testing.T, bucket string) *Server {
+	s := &Server{bucket: bucket, subresource: map[string][]byte{}, objects: map[string]object{}}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// BucketURL returns the path style URL of the bucket
+func (s *Server) BucketURL() string {
+	return s.URL + "/" + s.bucket
+}
+
+// Subresource returns the raw stored bucket sub resource, e.g. `policy`
+func (s *Server) Subresource(name string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b, ok := s.subresource[name]
+	return b, ok
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+AccessKey+"/") {
+		writeError(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	if r.URL.Path == "/"+s.bucket {
+		s.serveBucket(w, r)
+		return
+	}
+	if key := strings.TrimPrefix(r.URL.Path, "/"+s.bucket+"/"); key != r.URL.Path && key != "" {
+		s.serveObject(w, r, key)
+		return
+	}
+	writeError(w, http.StatusNotFound, "NoSuchBucket")
+}
+
+// Object returns the body of a stored object
+func (s *Server) Object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[key]
+	return o.body, ok
+}
+
+// PutObject stores an object, e.g. to simulate changes outside of terraform
+func (s *Server) PutObject(key string, body []byte, contentType string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = newObject(body, http.Header{"Content-Type": []string{contentType}})
+}
+
+func newObject(body []byte, h http.Header) object {
+	sum := md5.Sum(body) //nolint:gosec // ETag of the S3 API
+	header := http.Header{}
+	header.Set("ETag", `"`+hex.EncodeToString(sum[:])+`"`)
+	header.Set("Content-Type", "binary/octet-stream")
+	if ct := h.Get("Content-Type"); ct != "" {
+		header.Set("Content-Type", ct)
+	}
+	for k, v := range h {
+		if strings.HasPrefix(k, "X-Amz-Meta-") {
+			header[k] = v
+		}
+	}
+	return object{body: body, header: header, lastModified: time.Now().UTC()}
+}
+
+func (s *Server) serveObject(w http.ResponseWriter, r *http.Request, key string) {
+	switch r.Method {
+	case http.MethodPut:
+		b, _ := io.ReadAll(r.Body)
+		o := newObject(b, r.Header)
+		s.objects[key] = o
+		w.Header().Set("ETag", o.header.Get("ETag"))
+	case http.MethodGet, http.MethodHead:
+		o, ok := s.objects[key]
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		for k, v := range o.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Last-Modified", o.lastModified.Format(http.TimeFormat))
+		w.Header().Set("Content-Length", strconv.Itoa(len(o.body)))
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(o.body)
+		}
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (s *Server) serveBucket(w http.ResponseWriter, r *http.Request) {
+	sub := ""
+	for k := range r.URL.Query() {
+		sub = k
+	}
+	if sub == "" {
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		if sub == "lifecycle" && r.Header.Get("Content-Md5") == "" {
+			writeError(w, http.StatusBadRequest, "InvalidRequest")
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		s.subresource[sub] = b
+	case http.MethodGet:
+		b, ok := s.subresource[sub]
+		if !ok && sub == "versioning" {
+			b, ok = []byte(`<VersioningConfiguration></VersioningConfiguration>`), true
+		}
+		if !ok {
+			writeError(w, http.StatusNotFound, "NoSuch"+strings.ToUpper(sub[:1])+sub[1:])
+			return
+		}
+		_, _ = w.Write(b)
+	case http.MethodDelete:
+		delete(s.subresource, sub)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte("<Error><Code>" + code + "</Code><Message>" + http.StatusText(status) + "</Message></Error>"))
+}
@@ -0,0 +1,137 @@
+// Package vault provides a minimal client for the Vault compatible API of STACKIT Secrets Manager
+// secrets are stored in the KV version 2 secrets engine, which is mounted at the instance ID
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client is a KV v2 client authenticated with the credentials of a Secrets Manager user
+type Client struct {
+	apiURL     string
+	username   string
+	password   string
+	token      string
+	httpClient *http.Client
+}
+
+// Error is a Vault error response
+type Error struct {
+	StatusCode int      `json:"-"`
+	Errors     []string `json:"errors"`
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("Secrets Manager request failed with status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("Secrets Manager request failed with status code %d: %s", e.StatusCode, strings.Join(e.Errors, ", "))
+}
+
+// IsNotFound returns true if the error is a 404 error
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// New returns a new client for the given API URL
+// the client logs in with the user's credentials on the first request
+func New(apiURL, username, password string) *Client {
+	return &Client{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: time.Minute},
+	}
+}
+
+// login retrieves a token using the userpass auth method
+func (c *Client) login(ctx context.Context) error {
+	if c.token != "" {
+		return nil
+	}
+	res := struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}{}
+	p := "auth/userpass/login/" + url.PathEscape(c.username)
+	if err := c.do(ctx, http.MethodPost, p, nil, map[string]string{"password": c.password}, &res); err != nil {
+		return fmt.Errorf("failed to login: %w", err)
+	}
+	if res.Auth.ClientToken == "" {
+		return errors.New("failed to login: received empty token")
+	}
+	c.token = res.Auth.ClientToken
+	return nil
+}
+
+func (c *Client) request(ctx context.Context, method, p string, query url.Values, body, result interface{}) error {
+	if err := c.login(ctx); err != nil {
+		return err
+	}
+	return c.do(ctx, method, p, query, body, result)
+}
+
+func (c *Client) do(ctx context.Context, method, p string, query url.Values, body, result interface{}) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	u := c.apiURL + "/v1/" + p
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, r)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("X-Vault-Token", c.token)
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		e := &Error{}
+		_ = json.Unmarshal(b, e)
+		e.StatusCode = res.StatusCode
+		return e
+	}
+	if result == nil || len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, result)
+}
+
+// secretPath escapes the secret path segments
+func secretPath(mount, kind, p string) string {
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, s := range segments {
+		segments[i] = url.PathEscape(s)
+	}
+	return url.PathEscape(mount) + "/" + kind + "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/common"
+	"github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/vault/vaulttest"
+)
+
+func TestKV(t *testing.T) {
+	// don't run during acceptance tests
+	if common.ShouldAccTestRun(false) {
+		t.Skip()
+		return
+	}
+
+	ctx := context.Background()
+	srv := vaulttest.NewServer(t, "instance")
+
+	// invalid credentials
+	c := New(srv.URL, vaulttest.Username, "wrong")
+	if _, err := c.ReadSecret(ctx, "instance", "db/password", 0); err == nil {
+		t.Fatal("expected login error")
+	}
+
+	c = New(srv.URL+"/", vaulttest.Username, vaulttest.Password)
+	if _, err := c.ReadSecret(ctx, "instance", "db/password", 0); !IsNotFound(err) {
+		t.Fatalf("ReadSecret() expected not found, got %v", err)
+	}
+
+	// versions
+	for i, pw := range []string{"first", "second"} {
+		m, err := c.WriteSecret(ctx, "instance", "db/password", map[string]string{"password": pw})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Version != int64(i+1) || m.CreatedTime == "" {
+			t.Errorf("WriteSecret() = %+v", m)
+		}
+	}
+	s, err := c.ReadSecret(ctx, "instance", "db/password", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.StringData()["password"] != "second" || s.Metadata.Version != 2 {
+		t.Errorf("ReadSecret() = %+v", s)
+	}
+	s, err = c.ReadSecret(ctx, "instance", "db/password", 1)
+	if err != nil || s.StringData()["password"] != "first" {
+		t.Errorf("ReadSecret() version 1 = %+v, %v", s, err)
+	}
+
+	// metadata
+	if err := c.WriteMetadata(ctx, "instance", "db/password", map[string]string{"owner": "team"}, 5); err != nil {
+		t.Fatal(err)
+	}
+	m, err := c.ReadMetadata(ctx, "instance", "db/password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.CurrentVersion != 2 || m.MaxVersions != 5 || m.Custom["owner"] != "team" {
+		t.Errorf("ReadMetadata() = %+v", m)
+	}
+
+	// non string values are JSON encoded
+	if got := (Secret{Data: map[string]interface{}{"port": float64(5432), "tags": []interface{}{"a"}}}).StringData(); got["port"] != "5432" || got["tags"] != `["a"]` {
+		t.Errorf("StringData() = %v", got)
+	}
+
+	// soft deleted versions are not found
+	srv.SoftDelete("db/password")
+	if _, err := c.ReadSecret(ctx, "instance", "db/password", 0); !IsNotFound(err) {
+		t.Fatalf("ReadSecret() expected not found, got %v", err)
+	}
+
+	if err := c.DeleteMetadata(ctx, "instance", "db/password"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ReadMetadata(ctx, "instance", "db/password"); !IsNotFound(err) {
+		t.Fatalf("ReadMetadata() expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Secret is a single version of a secret
+type Secret struct {
+	Data     map[string]interface{} `json:"data"`
+	Metadata VersionMetadata        `json:"metadata"`
+}
+
+// VersionMetadata describes a single secret version
+type VersionMetadata struct {
+	Version      int64             `json:"version"`
+	CreatedTime  string            `json:"created_time"`
+	DeletionTime string            `json:"deletion_time"`
+	Destroyed    bool              `json:"destroyed"`
+	Custom       map[string]string `json:"custom_metadata"`
+}
+
+// Metadata describes all versions of a secret
+type Metadata struct {
+	CurrentVersion int64             `json:"current_version"`
+	MaxVersions    int64             `json:"max_versions"`
+	CreatedTime    string            `json:"created_time"`
+	UpdatedTime    string            `json:"updated_time"`
+	Custom         map[string]string `json:"custom_metadata"`
+}
+
+// StringData returns the secret data as strings, non string values are JSON encoded
+func (s Secret) StringData() map[string]string {
+	data := map[string]string{}
+	for k, v := range s.Data {
+		if str, ok := v.(string); ok {
+			data[k] = str
+			continue
+		}
+		b, _ := json.Marshal(v)
+		data[k] = string(b)
+	}
+	return data
+}
+
+// ReadSecret reads a secret version, version 0 reads the latest version
+func (c *Client) ReadSecret(ctx context.Context, mount, path string, version int64) (Secret, error) {
+	res := struct {
+		Data Secret `json:"data"`
+	}{}
+	query := url.Values{}
+	if version > 0 {
+		query.Set("version", strconv.FormatInt(version, 10))
+	}
+	err := c.request(ctx, http.MethodGet, secretPath(mount, "data", path), query, nil, &res)
+	return res.Data, err
+}
+
+// WriteSecret writes a new version of the secret and returns its metadata
+func (c *Client) WriteSecret(ctx context.Context, mount, path string, data map[string]string) (VersionMetadata, error) {
+	res := struct {
+		Data VersionMetadata `json:"data"`
+	}{}
+	err := c.request(ctx, http.MethodPost, secretPath(mount, "data", path), nil, map[string]interface{}{"data": data}, &res)
+	return res.Data, err
+}
+
+// ReadMetadata reads the metadata of all versions
+func (c *Client) ReadMetadata(ctx context.Context, mount, path string) (Metadata, error) {
+	res := struct {
+		Data Metadata `json:"data"`
+	}{}
+	err := c.request(ctx, http.MethodGet, secretPath(mount, "metadata", path), nil, nil, &res)
+	return res.Data, err
+}
+
+// WriteMetadata sets the custom metadata and the maximum number of versions
+// max versions of 0 uses the engine's default
+func (c *Client) WriteMetadata(ctx context.Context, mount, path string, custom map[string]string, maxVersions int64) error {
+	if custom == nil {
+		custom = map[string]string{}
+	}
+	body := map[string]interface{}{
+		"custom_metadata": custom,
+		"max_versions":    maxVersions,
+	}
+	return c.request(ctx, http.MethodPost, secretPath(mount, "metadata", path), nil, body, nil)
+}
+
+// DeleteMetadata permanently deletes the secret and all its versions
+func (c *Client) DeleteMetadata(ctx context.Context, mount, path string) error {
+	return c.request(ctx, http.MethodDelete, secretPath(mount, "metadata", path), nil, nil, nil)
+}
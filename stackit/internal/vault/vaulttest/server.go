@@ -0,0 +1,225 @@
+// Package vaulttest provides a local in-memory stand-in for the Vault compatible Secrets Manager API to be used in unit tests
+package vaulttest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// credentials accepted by the server
+const (
+	Username = "user"
+	Password = "password"
+	token    = "token"
+)
+
+// Server is an in-memory KV v2 secrets engine mounted at a single path
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	mount   string
+	secrets map[string]*secret
+}
+
+type secret struct {
+	versions    []version
+	custom      map[string]string
+	maxVersions int64
+	created     time.Time
+}
+
+type version struct {
+	data    map[string]interface{}
+	created time.Time
+	deleted time.Time
+}
+
+// NewServer starts a new server which is closed when the test finishes
+func NewServer(t *testing.T, mount string) *Server {
+	s := &Server{mount: mount, secrets: map[string]*secret{}}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// Versions returns the number of versions of a secret
+func (s *Server) Versions(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sec, ok := s.secrets[path]; ok {
+		return len(sec.versions)
+	}
+	return 0
+}
+
+// SoftDelete deletes the latest version of a secret, e.g. to simulate changes outside of terraform
+func (s *Server) SoftDelete(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sec, ok := s.secrets[path]; ok && len(sec.versions) > 0 {
+		sec.versions[len(sec.versions)-1].deleted = time.Now().UTC()
+	}
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := strings.TrimPrefix(r.URL.Path, "/v1/")
+	if strings.HasPrefix(p, "auth/userpass/login/") {
+		s.login(w, r, strings.TrimPrefix(p, "auth/userpass/login/"))
+		return
+	}
+	if r.Header.Get("X-Vault-Token") != token {
+		writeError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	parts := strings.SplitN(p, "/", 3)
+	if len(parts) != 3 || parts[0] != s.mount {
+		writeError(w, http.StatusNotFound, "no handler for route")
+		return
+	}
+	switch parts[1] {
+	case "data":
+		s.data(w, r, parts[2])
+	case "metadata":
+		s.metadata(w, r, parts[2])
+	default:
+		writeError(w, http.StatusNotFound, "no handler for route")
+	}
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request, username string) {
+	body := struct {
+		Password string `json:"password"`
+	}{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	if username != Username || body.Password != Password {
+		writeError(w, http.StatusBadRequest, "invalid username or password")
+		return
+	}
+	writeJSON(w, map[string]interface{}{"auth": map[string]interface{}{"client_token": token}})
+}
+
+func (s *Server) data(w http.ResponseWriter, r *http.Request, path string) {
+	sec := s.secrets[path]
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		body := struct {
+			Data map[string]interface{} `json:"data"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Data == nil {
+			writeError(w, http.StatusBadRequest, "no data provided")
+			return
+		}
+		if sec == nil {
+			sec = &secret{custom: map[string]string{}, created: time.Now().UTC()}
+			s.secrets[path] = sec
+		}
+		sec.versions = append(sec.versions, version{data: body.Data, created: time.Now().UTC()})
+		writeJSON(w, map[string]interface{}{"data": versionMetadata(sec, len(sec.versions))})
+	case http.MethodGet:
+		if sec == nil || len(sec.versions) == 0 {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		n := len(sec.versions)
+		if v := r.URL.Query().Get("version"); v != "" {
+			n, _ = strconv.Atoi(v)
+		}
+		if n < 1 || n > len(sec.versions) {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		v := sec.versions[n-1]
+		var data map[string]interface{}
+		status := http.StatusOK
+		if v.deleted.IsZero() {
+			data = v.data
+		} else {
+			// vault responds with 404 and the metadata for deleted versions
+			status = http.StatusNotFound
+		}
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{
+			"data":     data,
+			"metadata": versionMetadata(sec, n),
+		}})
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) metadata(w http.ResponseWriter, r *http.Request, path string) {
+	sec := s.secrets[path]
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		body := struct {
+			Custom      map[string]string `json:"custom_metadata"`
+			MaxVersions int64             `json:"max_versions"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if sec == nil {
+			sec = &secret{custom: map[string]string{}, created: time.Now().UTC()}
+			s.secrets[path] = sec
+		}
+		if body.Custom != nil {
+			sec.custom = body.Custom
+		}
+		sec.maxVersions = body.MaxVersions
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		if sec == nil {
+			writeError(w, http.StatusNotFound)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"data": map[string]interface{}{
+			"current_version": len(sec.versions),
+			"max_versions":    sec.maxVersions,
+			"created_time":    sec.created.Format(time.RFC3339Nano),
+			"custom_metadata": sec.custom,
+		}})
+	case http.MethodDelete:
+		delete(s.secrets, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed)
+	}
+}
+
+func versionMetadata(sec *secret, n int) map[string]interface{} {
+	v := sec.versions[n-1]
+	deleted := ""
+	if !v.deleted.IsZero() {
+		deleted = v.deleted.Format(time.RFC3339Nano)
+	}
+	return map[string]interface{}{
+		"version":         n,
+		"created_time":    v.created.Format(time.RFC3339Nano),
+		"deletion_time":   deleted,
+		"destroyed":       false,
+		"custom_metadata": sec.custom,
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, errs ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if errs == nil {
+		errs = []string{}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": errs})
+}
@@ -2,6 +2,9 @@ package validate
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"regexp"
 
 	clientValidate "github.com/SchwarzIT/community-stackit-go-client/pkg/validate"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -39,6 +42,24 @@ func ProjectName() *Validator {
 	}
 }
 
+func FolderName() *Validator {
+	return &Validator{
+		description: "validate folder name",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			v, diag := req.ConfigValue.ToStringValue(ctx)
+			if diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+			exp := `^[a-zA-ZäüöÄÜÖ0-9][ a-zA-ZäüöÄÜÖß0-9_+&-]{1,39}$`
+			if !regexp.MustCompile(exp).MatchString(v.ValueString()) {
+				err := fmt.Errorf("invalid folder name. valid name is of: %s", exp)
+				resp.Diagnostics.AddError(err.Error(), err.Error())
+			}
+		},
+	}
+}
+
 func ProjectID() *Validator {
 	return &Validator{
 		description: "validate project ID",
@@ -178,3 +199,25 @@ func PublicIP() *Validator {
 		},
 	}
 }
+
+func CIDR() *Validator {
+	return &Validator{
+		description: "validate CIDR",
+		validate: func(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			v, diag := req.ConfigValue.ToStringValue(ctx)
+			if diag.HasError() {
+				resp.Diagnostics.Append(diag...)
+				return
+			}
+			ip, n, err := net.ParseCIDR(v.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("invalid CIDR", err.Error())
+				return
+			}
+			if !ip.Equal(n.IP) {
+				err := fmt.Errorf("CIDR %s has host bits set, did you mean %s?", v.ValueString(), n.String())
+				resp.Diagnostics.AddError("invalid CIDR", err.Error())
+			}
+		},
+	}
+}
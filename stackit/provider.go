@@ -2,11 +2,15 @@ package stackit
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/SchwarzIT/community-stackit-go-client/pkg/services"
 	dataArgusInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/argus/instance"
 	dataArgusJob "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/argus/job"
+	dataDataServicesBackups "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/data-services/backups"
 	dataDataServicesCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/data-services/credential"
 	dataDataServicesInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/data-services/instance"
+	dataDataServicesOfferings "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/data-services/offerings"
 	dataKubernetesCluster "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/kubernetes/cluster"
 	dataKubernetesProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/kubernetes/project"
 	dataLoadBalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/load-balancer"
@@ -16,11 +20,15 @@ import (
 	dataObjectStorageBucket "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/bucket"
 	dataObjectStorageCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/credential"
 	dataObjectStorageCredentialsGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/credentials-group"
+	dataObjectStorageObject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/object"
 	dataObjectStorageProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/object-storage/project"
 	dataPostgresFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/instance"
 	dataPostgresFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/postgres-flex/user"
 	dataProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/project"
+	dataProjects "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/projects"
+	dataResourceManagerContainer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/resource-manager/container"
 	dataSecretsManagerInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/instance"
+	dataSecretsManagerSecret "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/secret"
 	dataSecretsManagerUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/data-sources/secrets-manager/user"
 
 	resourceArgusCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/credential"
@@ -28,26 +36,51 @@ import (
 	resourceArgusJob "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/argus/job"
 	resourceDataServicesCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/credential"
 	resourceDataServicesInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/instance"
+	resourceDataServicesRestore "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/data-services/restore"
+	resourceKeypair "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/keypair"
 	resourceKubernetesCluster "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/cluster"
 	resourceKubernetesProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/kubernetes/project"
 	resourceLoadBalancer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/load-balancer"
 	resourceMongoDBFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/instance"
 	resourceMongoDBFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/mongodb-flex/user"
 	resourceNetwork "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network"
+	resourceNetworkArea "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network-area/area"
+	resourceNetworkAreaRoute "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network-area/route"
+	resourceNetworkInterface "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/network-interface"
 	resourceObjectStorageBucket "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket"
+	resourceObjectStorageBucketAccess "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket-access"
+	resourceObjectStorageBucketPolicy "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/bucket-policy"
 	resourceObjectStorageCredential "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/credential"
 	resourceObjectStorageCredentialsGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/credentials-group"
+	resourceObjectStorageObject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/object"
 	resourceObjectStorageProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/object-storage/project"
 	resourcePostgresFlexInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/instance"
 	resourcePostgresFlexUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/postgres-flex/user"
 	resourceProject "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/project"
+	resourceProjectMember "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/project-member"
+	resourceProjectMembers "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/project-members"
+	resourcePublicIP "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/public-ip"
+	resourceResourceManagerFolder "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/resource-manager/folder"
 	resourceSecretsManagerInstance "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/instance"
+	resourceSecretsManagerSecret "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/secret"
 	resourceSecretsManagerUser "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/secrets-manager/user"
-
+	resourceSecurityGroup "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/security-group/group"
+	resourceSecurityGroupRule "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/security-group/rule"
+	resourceServer "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/server"
+	resourceServerVolumeAttachment "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/server-volume-attachment"
+	resourceServiceAccount "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account/account"
+	resourceServiceAccountKey "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account/key"
+	resourceServiceAccountToken "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/service-account/token"
+	resourceVolume "github.com/SchwarzIT/terraform-provider-stackit/stackit/internal/resources/volume"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -63,6 +96,14 @@ const (
 	// Key Flow optional env variable (2) using file paths
 	ServiceAccountKeyPath = "STACKIT_SERVICE_ACCOUNT_KEY_PATH"
 	PrivateKeyPath        = "STACKIT_PRIVATE_KEY_PATH"
+
+	// Authentication method and credentials file
+	AuthMethod      = "STACKIT_AUTH_METHOD"
+	CredentialsFile = "STACKIT_CREDENTIALS_FILE"
+	Profile         = "STACKIT_PROFILE"
+
+	// Region of the service APIs
+	Region = "STACKIT_REGION"
 )
 
 // New returns a new STACKIT provider function
@@ -76,6 +117,9 @@ func New(version string) func() provider.Provider {
 
 type StackitProvider struct {
 	version string
+
+	// client is the configured client, its response cache is released when the provider is configured again
+	client *services.Services
 }
 
 var _ = provider.Provider(&StackitProvider{})
@@ -94,8 +138,16 @@ type providerSchema struct {
 	ServiceAccountKeyPath types.String `tfsdk:"service_account_key_path"`
 	PrivateKeyPath        types.String `tfsdk:"private_key_path"`
 
+	// Credentials file and impersonation
+	AuthMethod      types.String `tfsdk:"auth_method"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+	Profile         types.String `tfsdk:"profile"`
+	Impersonate     types.Object `tfsdk:"impersonate"`
+
 	// General
-	EnableTraceContext types.Bool `tfsdk:"enable_trace_context"`
+	EnableTraceContext types.Bool   `tfsdk:"enable_trace_context"`
+	Region             types.String `tfsdk:"region"`
+	Endpoints          types.Object `tfsdk:"endpoints"`
 }
 
 // Schema returns the provider's schema
@@ -130,10 +182,63 @@ func (p *StackitProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Optional:            true,
 				MarkdownDescription: "Path to the Private RSA Key.<br />This attribute can also be loaded from `STACKIT_PRIVATE_KEY_PATH` environment variable instead.",
 			},
+			"auth_method": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Authentication method, one of `key`, `token` or `credentials_file`. If not set, the key flow is tried before the token flow.<br />This attribute can also be loaded from `STACKIT_AUTH_METHOD` environment variable instead.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(authMethods...),
+				},
+			},
+			"credentials_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to the credentials file used with `auth_method = \"credentials_file\"`. Default: `~/.stackit/credentials.json`<br />This attribute can also be loaded from `STACKIT_CREDENTIALS_FILE` environment variable instead.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Profile of the credentials file. Default: `default`<br />This attribute can also be loaded from `STACKIT_PROFILE` environment variable instead.",
+			},
 			"enable_trace_context": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: "Enable trace context. If `true` a `Traceparent` header will be added to the request. Default: `false`",
 			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Region of the regional service APIs, for example `eu01`. Global APIs, like the resource manager, aren't affected. Default: `eu01`<br />This attribute can also be loaded from `STACKIT_REGION` environment variable instead.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z]{2}\d{2}$`), "must be a region like eu01"),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"endpoints": endpointsBlock(),
+			"impersonate": schema.SingleNestedBlock{
+				MarkdownDescription: "Impersonates a service account: a short-lived access token of the service account is created with the configured credentials and used for all requests. " +
+					"The token is cached in `impersonation_tokens.json` next to the credentials file and reused while it's valid for at least another hour, afterwards a new token is created. " +
+					"Tokens aren't revoked and stay valid until `ttl_days` have passed.",
+				Attributes: map[string]schema.Attribute{
+					"project_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "ID of the project the service account belongs to.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("service_account_email")),
+						},
+					},
+					"service_account_email": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Email of the service account to impersonate.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("project_id")),
+						},
+					},
+					"ttl_days": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Lifetime of the access tokens in days, between `1` and `180`. Longer lifetimes cause a warning, as the tokens aren't revoked. Default: `1`",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 180),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -163,21 +268,48 @@ func (p *StackitProvider) Resources(_ context.Context) []func() resource.Resourc
 		resourceDataServicesInstance.NewOpensearch,
 		resourceDataServicesInstance.NewRabbitMQ,
 		resourceDataServicesInstance.NewRedis,
+		resourceDataServicesRestore.NewElasticSearch,
+		resourceDataServicesRestore.NewLogMe,
+		resourceDataServicesRestore.NewMariaDB,
+		resourceDataServicesRestore.NewOpensearch,
+		resourceDataServicesRestore.NewPostgres,
+		resourceDataServicesRestore.NewRabbitMQ,
+		resourceDataServicesRestore.NewRedis,
 		resourceKubernetesCluster.New,
 		resourceKubernetesProject.New,
 		resourceLoadBalancer.New,
 		resourceMongoDBFlexInstance.New,
 		resourceMongoDBFlexUser.New,
 		resourceObjectStorageBucket.New,
+		resourceObjectStorageBucketAccess.New,
+		resourceObjectStorageBucketPolicy.New,
 		resourceObjectStorageCredential.New,
 		resourceObjectStorageCredentialsGroup.New,
+		resourceObjectStorageObject.New,
 		resourceObjectStorageProject.New,
 		resourcePostgresFlexInstance.New,
 		resourcePostgresFlexUser.New,
 		resourceProject.New,
+		resourceProjectMember.New,
+		resourceProjectMembers.New,
+		resourceResourceManagerFolder.New,
 		resourceSecretsManagerInstance.New,
+		resourceSecretsManagerSecret.New,
 		resourceSecretsManagerUser.New,
+		resourceServiceAccount.New,
+		resourceServiceAccountKey.New,
+		resourceServiceAccountToken.New,
 		resourceNetwork.New,
+		resourceServer.New,
+		resourceVolume.New,
+		resourceServerVolumeAttachment.New,
+		resourceKeypair.New,
+		resourceSecurityGroup.New,
+		resourceSecurityGroupRule.New,
+		resourceNetworkInterface.New,
+		resourcePublicIP.New,
+		resourceNetworkArea.New,
+		resourceNetworkAreaRoute.New,
 	}
 }
 
@@ -186,6 +318,13 @@ func (p *StackitProvider) DataSources(context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		dataArgusInstance.New,
 		dataArgusJob.New,
+		dataDataServicesBackups.NewElasticSearch,
+		dataDataServicesBackups.NewLogMe,
+		dataDataServicesBackups.NewMariaDB,
+		dataDataServicesBackups.NewOpensearch,
+		dataDataServicesBackups.NewPostgres,
+		dataDataServicesBackups.NewRabbitMQ,
+		dataDataServicesBackups.NewRedis,
 		dataDataServicesCredential.NewElasticSearch,
 		dataDataServicesCredential.NewLogMe,
 		dataDataServicesCredential.NewMariaDB,
@@ -200,6 +339,13 @@ func (p *StackitProvider) DataSources(context.Context) []func() datasource.DataS
 		dataDataServicesInstance.NewPostgres,
 		dataDataServicesInstance.NewRabbitMQ,
 		dataDataServicesInstance.NewRedis,
+		dataDataServicesOfferings.NewElasticSearch,
+		dataDataServicesOfferings.NewLogMe,
+		dataDataServicesOfferings.NewMariaDB,
+		dataDataServicesOfferings.NewOpensearch,
+		dataDataServicesOfferings.NewPostgres,
+		dataDataServicesOfferings.NewRabbitMQ,
+		dataDataServicesOfferings.NewRedis,
 		dataKubernetesCluster.New,
 		dataKubernetesProject.New,
 		dataLoadBalancer.New,
@@ -208,11 +354,15 @@ func (p *StackitProvider) DataSources(context.Context) []func() datasource.DataS
 		dataObjectStorageBucket.New,
 		dataObjectStorageCredential.New,
 		dataObjectStorageCredentialsGroup.New,
+		dataObjectStorageObject.New,
 		dataObjectStorageProject.New,
 		dataPostgresFlexInstance.New,
 		dataPostgresFlexUser.New,
 		dataProject.New,
+		dataProjects.New,
+		dataResourceManagerContainer.New,
 		dataSecretsManagerInstance.New,
+		dataSecretsManagerSecret.New,
 		dataSecretsManagerUser.New,
 		dataNetwork.New,
 	}
@@ -257,5 +407,40 @@ After the service account has been created, you can authenticate to the client u
    export STACKIT_SERVICE_ACCOUNT_TOKEN=token
    ` + "```" + `
 
+### Credentials file
+
+Credentials can be stored in named profiles of a credentials file, ` + "`~/.stackit/credentials.json`" + ` by default. A profile holds either the key flow or the token flow attributes, relative paths are resolved from the directory of the file:
+
+` + "```json" + `
+{
+  "default": {
+    "service_account_key_path": "sa_key.json",
+    "private_key_path": "private_key.pem"
+  },
+  "ci": {
+    "service_account_email": "email",
+    "service_account_token": "token"
+  }
+}
+` + "```" + `
+
+Set ` + "`auth_method = \"credentials_file\"`" + ` and select the profile with ` + "`profile`" + ` or ` + "`STACKIT_PROFILE`" + `.
+
+### Authentication method
+
+If ` + "`auth_method`" + ` isn't set, the key flow is tried before the token flow. Setting ` + "`auth_method`" + ` to ` + "`key`" + `, ` + "`token`" + ` or ` + "`credentials_file`" + ` only attempts that flow, so errors point to the misconfigured flow.
+
+### Impersonation
+
+With the ` + "`impersonate`" + ` block, the authenticated identity creates a short-lived access token of another service account and acts as that service account, for example to use one pipeline identity with a service account per project. The authenticated identity needs permission to create access tokens of the impersonated service account.
+
+The access token is cached per service account in ` + "`impersonation_tokens.json`" + ` in the directory of the credentials file (` + "`~/.stackit`" + ` by default) and reused by later runs while it's valid for at least another hour. Keep the file as private as the credentials file. Tokens aren't revoked, they stay valid until ` + "`ttl_days`" + ` (1 to 180 days, default 1) have passed.
+
+## Regions and endpoints
+
+Regional service APIs are called in ` + "`eu01`" + ` unless another ` + "`region`" + ` is configured. To manage resources in several regions, configure a provider alias per region.
+
+The base URL of each service API can be overridden in the ` + "`endpoints`" + ` block, for example to use a private API gateway. Configured endpoints take precedence over the ` + "`STACKIT_<SERVICE>_BASEURL`" + ` environment variables, which take precedence over ` + "`region`" + `.
+
 &nbsp;
 `